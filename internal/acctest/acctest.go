@@ -1788,6 +1788,27 @@ provider "aws" {
 `, tag1, value1))
 }
 
+func ConfigDefaultTags_ExcludeResourceTypes1(tag1, value1, typeName1 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    exclude_resource_types = [%[3]q]
+
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, tag1, value1, typeName1))
+}
+
 func ConfigDefaultTags_Tags2(tag1, value1, tag2, value2 string) string {
 	//lintignore:AT004
 	return ConfigCompose(
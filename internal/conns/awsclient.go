@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
@@ -20,6 +21,7 @@ import (
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
+	route53recoverycluster_sdkv1 "github.com/aws/aws-sdk-go/service/route53recoverycluster"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -35,19 +37,21 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	awsConfig                   *aws_sdkv2.Config
+	clients                     map[string]any
+	conns                       map[string]any
+	dnsSuffix                   string
+	endpoints                   map[string]string // From provider configuration.
+	httpClient                  *http.Client
+	lock                        sync.Mutex
+	logger                      baselogging.Logger
+	session                     *session_sdkv1.Session
+	s3ExpressClient             *s3_sdkv2.Client
+	s3DisableExpressSessionAuth bool           // From provider configuration.
+	s3UsePathStyle              bool           // From provider configuration.
+	s3USEast1RegionalEndpoint   string         // From provider configuration.
+	serviceMaxRetries           map[string]int // From provider configuration.
+	stsRegion                   string         // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -96,6 +100,13 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// Route53RecoveryClusterConnForEndpoint returns an AWS SDK For Go v1 Route 53 Recovery Cluster API client
+// for the specified cluster endpoint and AWS Region.
+// Route 53 Recovery Cluster API requests must be sent to one of a cluster's endpoints.
+func (c *AWSClient) Route53RecoveryClusterConnForEndpoint(ctx context.Context, endpoint, region string) *route53recoverycluster_sdkv1.Route53RecoveryCluster {
+	return route53recoverycluster_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithEndpoint(endpoint).WithRegion(region))
+}
+
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
@@ -252,6 +263,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	}
 	switch servicePackageName {
 	case names.S3:
+		m["s3_disable_express_session_auth"] = c.s3DisableExpressSessionAuth
 		m["s3_use_path_style"] = c.s3UsePathStyle
 		// AWS SDK for Go v2 does not use the AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable during configuration.
 		// For compatibility, read it now.
@@ -263,6 +275,22 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	// Per-service override of the maximum number of attempts.
+	if v, ok := c.serviceMaxRetries[servicePackageName]; ok {
+		cfg := c.awsConfig.Copy()
+		retryer := cfg.Retryer
+		cfg.Retryer = func() aws_sdkv2.Retryer {
+			if retryer == nil {
+				return retry_sdkv2.AddWithMaxAttempts(retry_sdkv2.NewStandard(), v)
+			}
+			return retry_sdkv2.AddWithMaxAttempts(retryer(), v)
+		}
+		m["aws_sdkv2_config"] = &cfg
+		if c.session != nil {
+			m["session"] = c.session.Copy(aws_sdkv1.NewConfig().WithMaxRetries(v))
+		}
+	}
+
 	return m
 }
 
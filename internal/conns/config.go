@@ -10,7 +10,10 @@ import (
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -29,7 +32,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -47,9 +50,11 @@ type Config struct {
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
+	S3DisableExpressSessionAuth    bool
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceMaxRetries              map[string]int
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -114,9 +119,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
-	}
+	// The first role is assumed by aws-sdk-go-base; any subsequent roles are chained below.
+	awsbaseAssumeRole, assumeRoleChain := SplitAssumeRoleChain(c.AssumeRole)
+	awsbaseConfig.AssumeRole = awsbaseAssumeRole
 
 	if c.CustomCABundle != "" {
 		awsbaseConfig.CustomCABundle = c.CustomCABundle
@@ -166,6 +171,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	for _, v := range assumeRoleChain {
+		tflog.Debug(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn": v.RoleARN,
+		})
+		cfg.Credentials = chainAssumeRole(cfg, v, c.STSRegion, c.Endpoints[names.STS])
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -224,13 +236,82 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.s3DisableExpressSessionAuth = c.S3DisableExpressSessionAuth
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceMaxRetries = c.ServiceMaxRetries
 	client.stsRegion = c.STSRegion
 
 	return client, diags
 }
 
+// chainAssumeRole returns a credentials provider that assumes the specified IAM Role
+// using the credentials configured in cfg.
+// SplitAssumeRoleChain returns the first configured role, which is assumed by aws-sdk-go-base,
+// and the remaining roles, which are assumed in order using the previous role's credentials.
+// Roles without an ARN are ignored.
+func SplitAssumeRoleChain(assumeRoles []awsbase.AssumeRole) (*awsbase.AssumeRole, []awsbase.AssumeRole) {
+	var first *awsbase.AssumeRole
+	var chain []awsbase.AssumeRole
+
+	for _, v := range assumeRoles {
+		if v.RoleARN == "" {
+			continue
+		}
+
+		if first == nil {
+			first = &v
+		} else {
+			chain = append(chain, v)
+		}
+	}
+
+	return first, chain
+}
+
+func chainAssumeRole(cfg aws_sdkv2.Config, assumeRole awsbase.AssumeRole, stsRegion, stsEndpoint string) aws_sdkv2.CredentialsProvider {
+	client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+		if stsRegion != "" {
+			o.Region = stsRegion
+		}
+		if stsEndpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+		}
+	})
+
+	provider := stscreds_sdkv2.NewAssumeRoleProvider(client, assumeRole.RoleARN, func(o *stscreds_sdkv2.AssumeRoleOptions) {
+		if assumeRole.Duration > 0 {
+			o.Duration = assumeRole.Duration
+		}
+		if assumeRole.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(assumeRole.ExternalID)
+		}
+		if assumeRole.Policy != "" {
+			o.Policy = aws_sdkv2.String(assumeRole.Policy)
+		}
+		for _, v := range assumeRole.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+		if assumeRole.SessionName != "" {
+			o.RoleSessionName = assumeRole.SessionName
+		}
+		if assumeRole.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(assumeRole.SourceIdentity)
+		}
+		for k, v := range assumeRole.Tags {
+			o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+		o.TransitiveTagKeys = assumeRole.TransitiveTagKeys
+	})
+
+	return aws_sdkv2.NewCredentialsCache(provider)
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
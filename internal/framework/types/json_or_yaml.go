@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	_ basetypes.StringTypable = (*jsonOrYAMLType)(nil)
+)
+
+type jsonOrYAMLType struct {
+	basetypes.StringType
+}
+
+var (
+	// JSONOrYAMLType is a custom type for documents, such as OpenAPI schemas, that may be written as either JSON or YAML.
+	JSONOrYAMLType = jsonOrYAMLType{}
+)
+
+func (t jsonOrYAMLType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonOrYAMLType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonOrYAMLType) String() string {
+	return "JSONOrYAMLType"
+}
+
+func (t jsonOrYAMLType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONOrYAMLNull(), diags
+	}
+	if in.IsUnknown() {
+		return JSONOrYAMLUnknown(), diags
+	}
+
+	return JSONOrYAML{StringValue: in}, diags
+}
+
+func (t jsonOrYAMLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonOrYAMLType) ValueType(context.Context) attr.Value {
+	return JSONOrYAML{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*JSONOrYAML)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONOrYAML)(nil)
+	_ xattr.ValidateableAttribute                = (*JSONOrYAML)(nil)
+)
+
+func JSONOrYAMLNull() JSONOrYAML {
+	return JSONOrYAML{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONOrYAMLUnknown() JSONOrYAML {
+	return JSONOrYAML{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONOrYAMLValue(value string) JSONOrYAML {
+	return JSONOrYAML{StringValue: basetypes.NewStringValue(value)}
+}
+
+type JSONOrYAML struct {
+	basetypes.StringValue
+}
+
+func (v JSONOrYAML) Equal(o attr.Value) bool {
+	other, ok := o.(JSONOrYAML)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONOrYAML) Type(context.Context) attr.Type {
+	return JSONOrYAMLType
+}
+
+func (v JSONOrYAML) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONOrYAML)
+
+	if !ok {
+		return false, diags
+	}
+
+	return jsonOrYAMLStringsEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+// JSON is a subset of YAML, so both documents are decoded with the YAML parser and compared structurally.
+// This also treats a JSON document and its YAML equivalent as equal.
+func jsonOrYAMLStringsEquivalent(s1, s2 string) bool {
+	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
+		return true
+	}
+
+	v1, err := unmarshalJSONOrYAML(s1)
+	if err != nil {
+		return false
+	}
+
+	v2, err := unmarshalJSONOrYAML(s2)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+func unmarshalJSONOrYAML(s string) (any, error) {
+	var v any
+
+	if err := yaml.Unmarshal([]byte(strings.ReplaceAll(s, "\r\n", "\n")), &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func (v JSONOrYAML) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := unmarshalJSONOrYAML(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON or YAML Value",
+			"The provided value is not a valid JSON or YAML string.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
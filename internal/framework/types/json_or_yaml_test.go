@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONOrYAMLValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.JSONOrYAML
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.JSONOrYAMLUnknown(),
+		},
+		"null": {
+			val: fwtypes.JSONOrYAMLNull(),
+		},
+		"valid JSON": {
+			val: fwtypes.JSONOrYAMLValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"valid YAML": {
+			val: fwtypes.JSONOrYAMLValue("Key1: Value\nKey2:\n  - 1\n  - 2\n  - 3\n"),
+		},
+		"invalid": {
+			val:         fwtypes.JSONOrYAMLValue("{not: ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestJSONOrYAMLStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.JSONOrYAML
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"both empty": {
+			val1:   fwtypes.JSONOrYAMLValue(``),
+			val2:   fwtypes.JSONOrYAMLValue(` `),
+			equals: true,
+		},
+		"JSON whitespace and key order": {
+			val1:   fwtypes.JSONOrYAMLValue(`{"openapi": "3.0.0", "info": {"title": "test", "version": "1.0.0"}}`),
+			val2:   fwtypes.JSONOrYAMLValue("{\n  \"info\": {\n    \"version\": \"1.0.0\",\n    \"title\": \"test\"\n  },\n  \"openapi\": \"3.0.0\"\n}\n"),
+			equals: true,
+		},
+		"YAML formatting": {
+			val1:   fwtypes.JSONOrYAMLValue("openapi: 3.0.0\ninfo:\n  title: test\n  version: 1.0.0\n"),
+			val2:   fwtypes.JSONOrYAMLValue("info: {version: 1.0.0, title: test}\r\nopenapi: 3.0.0\r\n"),
+			equals: true,
+		},
+		"JSON and YAML": {
+			val1:   fwtypes.JSONOrYAMLValue(`{"openapi": "3.0.0", "paths": {"/": {"get": {"parameters": [{"name": "id", "required": true}]}}}}`),
+			val2:   fwtypes.JSONOrYAMLValue("openapi: \"3.0.0\"\npaths:\n  /:\n    get:\n      parameters:\n        - name: id\n          required: true\n"),
+			equals: true,
+		},
+		"not equals": {
+			val1: fwtypes.JSONOrYAMLValue(`{"openapi": "3.0.0", "info": {"title": "test"}}`),
+			val2: fwtypes.JSONOrYAMLValue(`{"openapi": "3.0.0", "info": {"title": "test2"}}`),
+		},
+		"list order": {
+			val1: fwtypes.JSONOrYAMLValue(`{"tags": ["a", "b"]}`),
+			val2: fwtypes.JSONOrYAMLValue(`{"tags": ["b", "a"]}`),
+		},
+		"invalid": {
+			val1: fwtypes.JSONOrYAMLValue(`{"openapi": "3.0.0"}`),
+			val2: fwtypes.JSONOrYAMLValue(`{not: ok`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
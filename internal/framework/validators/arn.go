@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// arnServiceResourceValidator validates that a string Attribute's value is an ARN for a specific service and resource types.
+type arnServiceResourceValidator struct {
+	service       string
+	resourceTypes []string
+}
+
+// Description describes the validation in plain text formatting.
+func (validator arnServiceResourceValidator) Description(_ context.Context) string {
+	if len(validator.resourceTypes) == 0 {
+		return fmt.Sprintf("value must be a valid %s ARN", validator.service)
+	}
+
+	return fmt.Sprintf("value must be a valid %s %s ARN", validator.service, strings.Join(validator.resourceTypes, " or "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator arnServiceResourceValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator arnServiceResourceValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	f := verify.ValidARNCheck(verify.ARNServiceResourceCheck(validator.service, validator.resourceTypes...))
+
+	if _, errs := f(request.ConfigValue.ValueString(), request.Path.String()); len(errs) > 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+// ARNServiceResource returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid ARN for the specified service and,
+//     if any are specified, one of the resource types (e.g. "role" in "arn:aws:iam::123456789012:role/MyRole").
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ARNServiceResource(service string, resourceTypes ...string) validator.String {
+	return arnServiceResourceValidator{
+		service:       service,
+		resourceTypes: resourceTypes,
+	}
+}
+
+// IAMRoleARN returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid IAM role ARN.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IAMRoleARN() validator.String {
+	return ARNServiceResource("iam", "role")
+}
+
+// KMSKeyARN returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid KMS key ARN. KMS alias ARNs are invalid;
+//     use KMSKeyOrAliasARN for attributes that also accept aliases.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func KMSKeyARN() validator.String {
+	return ARNServiceResource("kms", "key")
+}
+
+// KMSKeyOrAliasARN returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid KMS key or alias ARN.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func KMSKeyOrAliasARN() validator.String {
+	return ARNServiceResource("kms", "key", "alias")
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ec2ResourceIDValidator validates that a string Attribute's value is an EC2-style resource ID with a specific prefix.
+type ec2ResourceIDValidator struct {
+	prefix string
+}
+
+// Description describes the validation in plain text formatting.
+func (validator ec2ResourceIDValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a valid resource ID beginning with '%s-'", validator.prefix)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator ec2ResourceIDValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator ec2ResourceIDValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := verify.ValidateEC2ResourceID(validator.prefix, request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+// EC2ResourceID returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents an EC2-style resource ID with the specified prefix,
+//     e.g. "subnet-0123456789abcdef0".
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func EC2ResourceID(prefix string) validator.String {
+	return ec2ResourceIDValidator{
+		prefix: prefix,
+	}
+}
+
+// SecurityGroupID returns a string validator which ensures that any configured
+// attribute value is a valid VPC security group ID.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func SecurityGroupID() validator.String {
+	return EC2ResourceID("sg")
+}
+
+// SubnetID returns a string validator which ensures that any configured
+// attribute value is a valid VPC subnet ID.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func SubnetID() validator.String {
+	return EC2ResourceID("subnet")
+}
+
+// VPCID returns a string validator which ensures that any configured
+// attribute value is a valid VPC ID.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func VPCID() validator.String {
+	return EC2ResourceID("vpc")
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,6 +67,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types to which default tags are not applied.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -164,6 +172,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"s3_disable_express_session_auth": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to disable S3 Express (directory bucket) session authentication,\n" +
+					"i.e., the CreateSession API is never called. Useful with S3-compatible, non-AWS\n" +
+					"endpoints. Specific to the Amazon S3 service.",
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -185,6 +200,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_retries": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "Map of service names, as used in the `endpoints` block, to the maximum number of times\n" +
+					"an AWS API request to that service is being executed. Overrides `max_retries` for the listed services.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -367,7 +389,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResourceType(typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -485,6 +507,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
+		S3DisableExpressSessionAuth:    d.Get("s3_disable_express_session_auth").(bool),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -514,14 +537,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		config.AssumeRole = expandAssumeRoles(ctx, v.([]interface{}))
+		for i, assumeRole := range config.AssumeRole {
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -534,6 +560,11 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		diags = append(diags, validateDefaultTagsExcludeResourceTypes(ctx, provider, config.DefaultTagsConfig.ExcludeResourceTypes)...)
+		if diags.HasError() {
+			return nil, diags
+		}
 	}
 
 	v := d.Get("endpoints")
@@ -571,6 +602,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_max_retries"); ok && len(v.(map[string]interface{})) > 0 {
+		serviceMaxRetries, dx := expandServiceMaxRetries(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceMaxRetries = serviceMaxRetries
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -607,7 +647,8 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
+		Description: "Roles to assume prior to making API calls. When multiple roles are configured they are " +
+			"assumed in order, each using the credentials obtained from the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -752,6 +793,26 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) []awsbase.AssumeRole {
+	var assumeRoles []awsbase.AssumeRole
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		assumeRole := expandAssumeRole(ctx, tfMap)
+		if assumeRole.RoleARN == "" {
+			continue
+		}
+
+		assumeRoles = append(assumeRoles, *assumeRole)
+	}
+
+	return assumeRoles
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -845,6 +906,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -852,6 +917,44 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+// validateDefaultTagsExcludeResourceTypes returns an error for each excluded resource type that is not implemented by the provider.
+func validateDefaultTagsExcludeResourceTypes(ctx context.Context, provider *schema.Provider, typeNames []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeNames = slices.DeleteFunc(slices.Clone(typeNames), func(typeName string) bool {
+		_, ok := provider.ResourcesMap[typeName]
+		return ok
+	})
+
+	if len(typeNames) == 0 {
+		return diags
+	}
+
+	// Terraform Plugin Framework resources are not in the ResourcesMap and only report their type name via Metadata.
+	frameworkTypeNames := make(map[string]struct{})
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				continue
+			}
+
+			response := fwresource.MetadataResponse{}
+			r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+			frameworkTypeNames[response.TypeName] = struct{}{}
+		}
+	}
+
+	for _, typeName := range typeNames {
+		if _, ok := frameworkTypeNames[typeName]; !ok {
+			diags = sdkdiag.AppendErrorf(diags, "default_tags.exclude_resource_types: unknown resource type %q", typeName)
+		}
+	}
+
+	return diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +973,46 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandServiceMaxRetries(_ context.Context, tfMap map[string]interface{}) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceMaxRetriesPath := cty.GetAttrPath("service_max_retries")
+	packages := names.ProviderPackages()
+	serviceMaxRetries := make(map[string]int)
+
+	for k, v := range tfMap {
+		pkg := k
+		if !slices.Contains(packages, pkg) {
+			var err error
+			if pkg, err = names.ProviderPackageForAlias(k); err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					serviceMaxRetriesPath.IndexString(k),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Unsupported service name %q.", k),
+				))
+
+				continue
+			}
+		}
+
+		// The AWS SDKs for Go v1 and v2 interpret 0 differently, so it is not allowed.
+		n := v.(int)
+		if n < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				serviceMaxRetriesPath.IndexString(k),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute must be at least 1, got: %d.", n),
+			))
+
+			continue
+		}
+
+		serviceMaxRetries[pkg] = n
+	}
+
+	return serviceMaxRetries, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
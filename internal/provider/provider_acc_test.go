@@ -7,10 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -110,6 +113,24 @@ func TestAccProvider_DefaultTagsTags_multiple(t *testing.T) {
 	})
 }
 
+func TestAccProvider_DefaultTagsExcludeResourceTypes_unknown(t *testing.T) {
+	ctx := acctest.Context(t)
+	var provider *schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactoriesInternal(ctx, t, &provider),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{ // nosemgrep:ci.test-config-funcs-correct-form
+				Config:      acctest.ConfigDefaultTags_ExcludeResourceTypes1("test", "value", "aws_instnace"),
+				ExpectError: regexache.MustCompile(`unknown resource type "aws_instnace"`),
+			},
+		},
+	})
+}
+
 func TestAccProvider_DefaultAndIgnoreTags_emptyBlocks(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider *schema.Provider
@@ -549,6 +570,30 @@ func TestAccProvider_AssumeRole_empty(t *testing.T) {
 	})
 }
 
+// The role in TF_ACC_ASSUME_ROLE_ARN must trust itself for the second hop of the chain.
+func TestAccProvider_AssumeRole_chained(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAssumeRoleARN(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_assumeRoleChained(os.Getenv(envvar.AccAssumeRoleARN), rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.aws_caller_identity.current", names.AttrARN, regexache.MustCompile(`:assumed-role/.+/`+rName+`$`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccProtoV5ProviderFactoriesInternal(ctx context.Context, t *testing.T, v **schema.Provider) map[string]func() (tfprotov5.ProviderServer, error) {
 	providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
 
@@ -988,6 +1033,24 @@ provider "aws" {
 data "aws_caller_identity" "current" {}
 ` //lintignore:AT004
 
+func testAccProviderConfig_assumeRoleChained(roleARN, sessionName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  assume_role {
+    role_arn = %[1]q
+  }
+
+  assume_role {
+    role_arn     = %[1]q
+    session_name = %[2]q
+  }
+}
+
+data "aws_caller_identity" "current" {}
+`, roleARN, sessionName)
+}
+
 const testAccProviderConfig_base = `
 data "aws_region" "provider_test" {}
 
@@ -8,10 +8,14 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -123,6 +127,232 @@ func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		serviceMaxRetries map[string]interface{}
+		expected          map[string]int
+		expectedDiags     diag.Diagnostics
+	}{
+		"package name": {
+			serviceMaxRetries: map[string]interface{}{
+				"ec2": 50,
+			},
+			expected: map[string]int{
+				names.EC2: 50,
+			},
+		},
+		"alias": {
+			serviceMaxRetries: map[string]interface{}{
+				"transcribeservice": 10,
+			},
+			expected: map[string]int{
+				names.Transcribe: 10,
+			},
+		},
+		"unknown service": {
+			serviceMaxRetries: map[string]interface{}{
+				"notaservice": 10,
+			},
+			expected: map[string]int{},
+			expectedDiags: diag.Diagnostics{errs.NewAttributeErrorDiagnostic(
+				cty.GetAttrPath("service_max_retries").IndexString("notaservice"),
+				"Invalid Attribute Value",
+				`Unsupported service name "notaservice".`,
+			)},
+		},
+		"zero": {
+			serviceMaxRetries: map[string]interface{}{
+				"ec2": 0,
+			},
+			expected: map[string]int{},
+			expectedDiags: diag.Diagnostics{errs.NewAttributeErrorDiagnostic(
+				cty.GetAttrPath("service_max_retries").IndexString("ec2"),
+				"Invalid Attribute Value",
+				"Attribute must be at least 1, got: 0.",
+			)},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandServiceMaxRetries(ctx, testcase.serviceMaxRetries)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		assumeRoles []interface{}
+		expected    []awsbase.AssumeRole
+	}{
+		"empty": {
+			assumeRoles: []interface{}{},
+			expected:    nil,
+		},
+		"single": {
+			assumeRoles: []interface{}{
+				map[string]interface{}{
+					"role_arn":     "arn:aws:iam::123456789012:role/first",
+					"session_name": "first-session",
+					"duration":     "1h",
+				},
+			},
+			expected: []awsbase.AssumeRole{
+				{
+					RoleARN:     "arn:aws:iam::123456789012:role/first",
+					SessionName: "first-session",
+					Duration:    time.Hour,
+				},
+			},
+		},
+		"multiple in order": {
+			assumeRoles: []interface{}{
+				map[string]interface{}{
+					"role_arn": "arn:aws:iam::123456789012:role/first",
+				},
+				map[string]interface{}{
+					"role_arn":    "arn:aws:iam::123456789012:role/second",
+					"external_id": "second-external-id",
+				},
+				map[string]interface{}{
+					"role_arn": "arn:aws:iam::210987654321:role/third",
+				},
+			},
+			expected: []awsbase.AssumeRole{
+				{
+					RoleARN: "arn:aws:iam::123456789012:role/first",
+				},
+				{
+					RoleARN:    "arn:aws:iam::123456789012:role/second",
+					ExternalID: "second-external-id",
+				},
+				{
+					RoleARN: "arn:aws:iam::210987654321:role/third",
+				},
+			},
+		},
+		"empty role_arn skipped": {
+			assumeRoles: []interface{}{
+				map[string]interface{}{
+					"role_arn":     "",
+					"session_name": "ignored",
+				},
+				map[string]interface{}{
+					"role_arn": "arn:aws:iam::123456789012:role/first",
+				},
+				nil,
+				map[string]interface{}{
+					"role_arn": "arn:aws:iam::123456789012:role/second",
+				},
+			},
+			expected: []awsbase.AssumeRole{
+				{
+					RoleARN: "arn:aws:iam::123456789012:role/first",
+				},
+				{
+					RoleARN: "arn:aws:iam::123456789012:role/second",
+				},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results := expandAssumeRoles(ctx, testcase.assumeRoles)
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		assumeRoles   []awsbase.AssumeRole
+		expectedFirst *awsbase.AssumeRole
+		expectedChain []awsbase.AssumeRole
+	}{
+		"none": {
+			assumeRoles:   nil,
+			expectedFirst: nil,
+			expectedChain: nil,
+		},
+		"single": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::123456789012:role/first"},
+			},
+			expectedFirst: &awsbase.AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/first"},
+			expectedChain: nil,
+		},
+		"chain": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::123456789012:role/first"},
+				{RoleARN: "arn:aws:iam::123456789012:role/second"},
+				{RoleARN: "arn:aws:iam::210987654321:role/third"},
+			},
+			expectedFirst: &awsbase.AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/first"},
+			expectedChain: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::123456789012:role/second"},
+				{RoleARN: "arn:aws:iam::210987654321:role/third"},
+			},
+		},
+		"empty role_arn skipped": {
+			assumeRoles: []awsbase.AssumeRole{
+				{SessionName: "ignored"},
+				{RoleARN: "arn:aws:iam::123456789012:role/first"},
+				{SessionName: "ignored"},
+				{RoleARN: "arn:aws:iam::123456789012:role/second"},
+			},
+			expectedFirst: &awsbase.AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/first"},
+			expectedChain: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::123456789012:role/second"},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			first, chain := conns.SplitAssumeRoleChain(testcase.assumeRoles)
+
+			if diff := cmp.Diff(first, testcase.expectedFirst); diff != "" {
+				t.Errorf("unexpected first role difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(chain, testcase.expectedChain); diff != "" {
+				t.Errorf("unexpected chain difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
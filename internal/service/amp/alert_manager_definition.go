@@ -36,8 +36,9 @@ func resourceAlertManagerDefinition() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"definition": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validAlertManagerDefinition,
 			},
 			"workspace_id": {
 				Type:     schema.TypeString,
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amp
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// alertManagerDefinitionData is the AMP alert manager definition file format.
+// See https://docs.aws.amazon.com/prometheus/latest/userguide/AMP-alertmanager-config.html.
+type alertManagerDefinitionData struct {
+	AlertmanagerConfig string            `yaml:"alertmanager_config"`
+	TemplateFiles      map[string]string `yaml:"template_files,omitempty"`
+}
+
+// ruleGroupNamespaceData is the Prometheus rules file format accepted by AMP.
+// See https://docs.aws.amazon.com/prometheus/latest/userguide/AMP-Ruler.html.
+type ruleGroupNamespaceData struct {
+	Groups []ruleGroupData `yaml:"groups"`
+}
+
+type ruleGroupData struct {
+	Interval    string     `yaml:"interval,omitempty"`
+	Limit       int        `yaml:"limit,omitempty"`
+	Name        string     `yaml:"name"`
+	QueryOffset string     `yaml:"query_offset,omitempty"`
+	Rules       []ruleData `yaml:"rules"`
+}
+
+type ruleData struct {
+	Alert         string            `yaml:"alert,omitempty"`
+	Annotations   map[string]string `yaml:"annotations,omitempty"`
+	Expr          string            `yaml:"expr"`
+	For           string            `yaml:"for,omitempty"`
+	KeepFiringFor string            `yaml:"keep_firing_for,omitempty"`
+	Labels        map[string]string `yaml:"labels,omitempty"`
+	Record        string            `yaml:"record,omitempty"`
+}
+
+func validAlertManagerDefinition(v interface{}, k string) (ws []string, errors []error) {
+	if err := checkAlertManagerDefinition(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid alert manager definition: %w", k, err))
+	}
+
+	return
+}
+
+func validRuleGroupNamespaceData(v interface{}, k string) (ws []string, errors []error) {
+	if err := checkRuleGroupNamespaceData(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid rule group namespace definition: %w", k, err))
+	}
+
+	return
+}
+
+func checkAlertManagerDefinition(s string) error {
+	var data alertManagerDefinitionData
+
+	if err := yaml.UnmarshalStrict([]byte(s), &data); err != nil {
+		return err
+	}
+
+	if data.AlertmanagerConfig == "" {
+		return errors.New("alertmanager_config is required")
+	}
+
+	var config map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(data.AlertmanagerConfig), &config); err != nil {
+		return fmt.Errorf("alertmanager_config: %w", err)
+	}
+
+	if _, ok := config["route"]; !ok {
+		return errors.New("alertmanager_config: route is required")
+	}
+
+	return nil
+}
+
+func checkRuleGroupNamespaceData(s string) error {
+	var data ruleGroupNamespaceData
+
+	if err := yaml.UnmarshalStrict([]byte(s), &data); err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{})
+	for i, group := range data.Groups {
+		if group.Name == "" {
+			return fmt.Errorf("groups[%d]: name is required", i)
+		}
+
+		if _, ok := names[group.Name]; ok {
+			return fmt.Errorf("groups[%d]: duplicate group name %q", i, group.Name)
+		}
+		names[group.Name] = struct{}{}
+
+		for j, rule := range group.Rules {
+			switch {
+			case rule.Alert == "" && rule.Record == "":
+				return fmt.Errorf("groups[%d].rules[%d]: one of alert or record is required", i, j)
+			case rule.Alert != "" && rule.Record != "":
+				return fmt.Errorf("groups[%d].rules[%d]: only one of alert or record can be set", i, j)
+			case rule.Expr == "":
+				return fmt.Errorf("groups[%d].rules[%d]: expr is required", i, j)
+			case rule.Record != "" && (rule.For != "" || rule.KeepFiringFor != "" || len(rule.Annotations) > 0):
+				return fmt.Errorf("groups[%d].rules[%d]: for, keep_firing_for and annotations are only valid for alerting rules", i, j)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package amp
+
+import (
+	"testing"
+)
+
+func TestValidAlertManagerDefinition(t *testing.T) {
+	t.Parallel()
+
+	validDefinitions := []string{
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		`
+template_files:
+  default_template: |
+    {{ define "sns.default.message" }}{{ .Status }}{{ end }}
+alertmanager_config: |
+  templates:
+    - 'default_template'
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+	}
+	for _, v := range validDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Prometheus Alert Manager Definition: %q", v, errors)
+		}
+	}
+
+	invalidDefinitions := []string{
+		``,
+		`not: [valid`,
+		`
+route:
+  receiver: 'default'
+`,
+		`
+alertmanager_config: |
+  receivers:
+    - name: 'default'
+`,
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+templates:
+  - 'default_template'
+`,
+	}
+	for _, v := range invalidDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Prometheus Alert Manager Definition", v)
+		}
+	}
+}
+
+func TestValidRuleGroupNamespaceData(t *testing.T) {
+	t.Parallel()
+
+	validData := []string{
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+  - name: alert-test
+    interval: 1m
+    rules:
+    - alert: metric:alerting_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m])) > 0
+      for: 2m
+      labels:
+        severity: page
+      annotations:
+        summary: High CPU
+`,
+	}
+	for _, v := range validData {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Prometheus Rule Group Namespace definition: %q", v, errors)
+		}
+	}
+
+	invalidData := []string{
+		`not: [valid`,
+		`
+rules:
+  - record: metric:recording_rule
+    expr: up
+`,
+		`
+groups:
+  - rules:
+    - record: metric:recording_rule
+      expr: up
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: up
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: up
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - expr: up
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - alert: metric:alerting_rule
+      record: metric:recording_rule
+      expr: up
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - alert: metric:alerting_rule
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: up
+      for: 2m
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expression: up
+`,
+	}
+	for _, v := range invalidData {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Prometheus Rule Group Namespace definition", v)
+		}
+	}
+}
@@ -65,6 +65,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_api_gateway_sdk",
 			Name:     "SDK",
 		},
+		{
+			Factory:  dataSourceUsage,
+			TypeName: "aws_api_gateway_usage",
+			Name:     "Usage",
+		},
 		{
 			Factory:  dataSourceVPCLink,
 			TypeName: "aws_api_gateway_vpc_link",
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	usageDateFormat = time.DateOnly
+)
+
+// @SDKDataSource("aws_api_gateway_usage", name="Usage")
+func dataSourceUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"end_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validUsageDate,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKeyID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"remaining": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"used": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validUsageDate,
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+	input := &apigateway.GetUsageInput{
+		EndDate:     aws.String(d.Get("end_date").(string)),
+		StartDate:   aws.String(d.Get("start_date").(string)),
+		UsagePlanId: aws.String(usagePlanID),
+	}
+
+	if v, ok := d.GetOk(names.AttrKeyID); ok {
+		input.KeyId = aws.String(v.(string))
+	}
+
+	items, err := findUsage(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan (%s) usage: %s", usagePlanID, err)
+	}
+
+	startDate, err := time.Parse(usageDateFormat, d.Get("start_date").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	id := fmt.Sprintf("%s:%s:%s", usagePlanID, d.Get("start_date").(string), d.Get("end_date").(string))
+	if v, ok := d.GetOk(names.AttrKeyID); ok {
+		id += ":" + v.(string)
+	}
+	d.SetId(id)
+	if err := d.Set("items", flattenUsageItems(items, startDate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting items: %s", err)
+	}
+
+	return diags
+}
+
+func findUsage(ctx context.Context, conn *apigateway.Client, input *apigateway.GetUsageInput) (map[string][][]int64, error) {
+	output := make(map[string][][]int64)
+
+	pages := apigateway.NewGetUsagePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range page.Items {
+			output[k] = append(output[k], v...)
+		}
+	}
+
+	return output, nil
+}
+
+// flattenUsageItems flattens the per-key usage returned by GetUsage.
+// Each key's usage is a list of [used, remaining] pairs, one per day starting at startDate.
+func flattenUsageItems(apiObject map[string][][]int64, startDate time.Time) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObject))
+
+	keyIDs := tfmaps.Keys(apiObject)
+	slices.Sort(keyIDs)
+
+	for _, keyID := range keyIDs {
+		var usage []interface{}
+
+		for i, v := range apiObject[keyID] {
+			tfMap := map[string]interface{}{
+				"date": startDate.AddDate(0, 0, i).Format(usageDateFormat),
+			}
+
+			if len(v) > 0 {
+				tfMap["used"] = v[0]
+			}
+			if len(v) > 1 {
+				tfMap["remaining"] = v[1]
+			}
+
+			usage = append(usage, tfMap)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKeyID: keyID,
+			"usage":         usage,
+		})
+	}
+
+	return tfList
+}
+
+func validUsageDate(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := time.Parse(usageDateFormat, value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a date in the format YYYY-MM-DD, got: %s", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayUsageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_api_gateway_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_plan_id", "aws_api_gateway_usage_plan.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrKeyID, "aws_api_gateway_api_key.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsageDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccUsagePlanKeyConfig_typeAPI(rName), `
+data "aws_api_gateway_usage" "test" {
+  usage_plan_id = aws_api_gateway_usage_plan_key.test.usage_plan_id
+  key_id        = aws_api_gateway_usage_plan_key.test.key_id
+  start_date    = formatdate("YYYY-MM-DD", timeadd(timestamp(), "-24h"))
+  end_date      = formatdate("YYYY-MM-DD", timestamp())
+}
+`)
+}
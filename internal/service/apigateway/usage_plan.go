@@ -9,17 +9,21 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -57,6 +61,7 @@ func resourceUsagePlan() *schema.Resource {
 						"throttle": {
 							Type:     schema.TypeSet,
 							Optional: true,
+							Set:      usagePlanThrottleHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"burst_limit": {
@@ -65,8 +70,10 @@ func resourceUsagePlan() *schema.Resource {
 										Optional: true,
 									},
 									names.AttrPath: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validUsagePlanThrottlePath,
+										StateFunc:    normalizeUsagePlanThrottlePath,
 									},
 									"rate_limit": {
 										Type:     schema.TypeFloat,
@@ -143,7 +150,10 @@ func resourceUsagePlan() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceUsagePlanCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -330,14 +340,15 @@ func resourceUsagePlanUpdate(ctx context.Context, d *schema.ResourceData, meta i
 					if t, ok := m["throttle"].(*schema.Set); ok && t.Len() > 0 {
 						for _, throttle := range t.List() {
 							th := throttle.(map[string]interface{})
+							path := normalizeUsagePlanThrottlePath(th[names.AttrPath])
 							operations = append(operations, types.PatchOperation{
 								Op:    types.OpReplace,
-								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/rateLimit", id, th[names.AttrPath].(string))),
+								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/rateLimit", id, path)),
 								Value: aws.String(strconv.FormatFloat(th["rate_limit"].(float64), 'f', -1, 64)),
 							})
 							operations = append(operations, types.PatchOperation{
 								Op:    types.OpReplace,
-								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/burstLimit", id, th[names.AttrPath].(string))),
+								Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/burstLimit", id, path)),
 								Value: aws.String(strconv.Itoa(th["burst_limit"].(int))),
 							})
 						}
@@ -510,6 +521,48 @@ func resourceUsagePlanDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceUsagePlanCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Throttle paths can only be validated once the REST API IDs and paths are known.
+	if !d.HasChange("api_stages") || !d.GetRawPlan().GetAttr("api_stages").IsWhollyKnown() {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	for _, tfMapRaw := range d.Get("api_stages").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiID, _ := tfMap["api_id"].(string)
+		throttle, _ := tfMap["throttle"].(*schema.Set)
+
+		if apiID == "" || throttle == nil || throttle.Len() == 0 {
+			continue
+		}
+
+		resources, err := findResources(ctx, conn, &apigateway.GetResourcesInput{
+			Embed:     []string{"methods"},
+			RestApiId: aws.String(apiID),
+		}, tfslices.PredicateTrue[*types.Resource]())
+
+		if err != nil {
+			return fmt.Errorf("reading API Gateway REST API (%s) resources: %w", apiID, err)
+		}
+
+		for _, v := range throttle.List() {
+			path := normalizeUsagePlanThrottlePath(v.(map[string]interface{})[names.AttrPath])
+
+			if !usagePlanThrottlePathMatchesResources(path, resources) {
+				return fmt.Errorf("api_stages throttle path %q does not match any method of API Gateway REST API (%s)", path, apiID)
+			}
+		}
+	}
+
+	return nil
+}
+
 func findUsagePlanByID(ctx context.Context, conn *apigateway.Client, id string) (*apigateway.GetUsagePlanOutput, error) {
 	input := &apigateway.GetUsagePlanInput{
 		UsagePlanId: aws.String(id),
@@ -677,7 +730,7 @@ func expandThrottleSettingsList(tfList []interface{}) map[string]types.ThrottleS
 		}
 
 		if v, ok := tfMap[names.AttrPath].(string); ok && v != "" {
-			apiObjects[v] = apiObject
+			apiObjects[normalizeUsagePlanThrottlePath(v)] = apiObject
 		}
 	}
 
@@ -701,3 +754,71 @@ func flattenThrottleSettingsMap(apiObjects map[string]types.ThrottleSettings) []
 
 	return tfList
 }
+
+var (
+	usagePlanThrottleMethodPathRegexp = regexache.MustCompile(`^([A-Z]+|\*) (/\S*)$`)
+	usagePlanThrottlePathRegexp       = regexache.MustCompile(`^(/\S*)/([A-Z]+|\*)$`)
+)
+
+// validUsagePlanThrottlePath accepts throttle paths either in the API's "/resource/path/METHOD" form
+// or in the "METHOD /resource/path" form.
+func validUsagePlanThrottlePath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !usagePlanThrottleMethodPathRegexp.MatchString(value) && !usagePlanThrottlePathRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be of the form \"/resource/path/METHOD\" or \"METHOD /resource/path\", got: %s", k, value))
+	}
+
+	return
+}
+
+// normalizeUsagePlanThrottlePath converts a "METHOD /resource/path" throttle path to the "/resource/path/METHOD" form.
+func normalizeUsagePlanThrottlePath(v interface{}) string {
+	value := v.(string)
+
+	if m := usagePlanThrottleMethodPathRegexp.FindStringSubmatch(value); m != nil {
+		return m[2] + "/" + m[1]
+	}
+
+	return value
+}
+
+func usagePlanThrottleHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+
+	return create.StringHashcode(fmt.Sprintf("%s-%d-%g", normalizeUsagePlanThrottlePath(tfMap[names.AttrPath]), tfMap["burst_limit"].(int), tfMap["rate_limit"].(float64)))
+}
+
+// usagePlanThrottlePathMatchesResources returns whether a "/resource/path/METHOD" throttle path refers to a method of one of the resources.
+// "*" may be used as the resource path or method to match any.
+func usagePlanThrottlePathMatchesResources(path string, resources []types.Resource) bool {
+	m := usagePlanThrottlePathRegexp.FindStringSubmatch(path)
+	if m == nil {
+		return false
+	}
+
+	resourcePath, httpMethod := m[1], m[2]
+	if resourcePath == "/*" && httpMethod == "*" {
+		return true
+	}
+
+	for _, resource := range resources {
+		if resourcePath != "/*" && resourcePath != aws.ToString(resource.Path) {
+			continue
+		}
+
+		if httpMethod == "*" && len(resource.ResourceMethods) > 0 {
+			return true
+		}
+
+		if _, ok := resource.ResourceMethods[httpMethod]; ok {
+			return true
+		}
+
+		if _, ok := resource.ResourceMethods["ANY"]; ok {
+			return true
+		}
+	}
+
+	return false
+}
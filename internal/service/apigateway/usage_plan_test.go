@@ -485,6 +485,43 @@ func TestAccAPIGatewayUsagePlan_APIStages_throttle(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayUsagePlan_APIStages_throttleMethodPath(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetUsagePlanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanConfig_apiStagesThrottleMethodPath(rName, "GET /test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "api_stages.0.throttle.*", map[string]string{
+						names.AttrPath: "/test/GET",
+						"burst_limit":  acctest.Ct3,
+						"rate_limit":   "6",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccUsagePlanConfig_apiStagesThrottleMethodPath(rName, "POST /test"),
+				ExpectError: regexache.MustCompile(`does not match any method`),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayUsagePlan_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetUsagePlanOutput
@@ -741,6 +778,25 @@ resource "aws_api_gateway_usage_plan" "test" {
 `, rName))
 }
 
+func testAccUsagePlanConfig_apiStagesThrottleMethodPath(rName, path string) string {
+	return acctest.ConfigCompose(testAccUsagePlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+
+    throttle {
+      path        = %[2]q
+      burst_limit = 3
+      rate_limit  = 6
+    }
+  }
+}
+`, rName, path))
+}
+
 func testAccUsagePlanConfig_apiStagesThrottleMulti(rName string) string {
 	return acctest.ConfigCompose(testAccUsagePlanConfig_base(rName), fmt.Sprintf(`
 resource "aws_api_gateway_usage_plan" "test" {
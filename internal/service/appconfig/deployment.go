@@ -17,9 +17,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -42,6 +44,10 @@ func ResourceDeployment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrApplicationID: {
 				Type:         schema.TypeString,
@@ -105,6 +111,11 @@ func ResourceDeployment() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 		CustomizeDiff: verify.SetTagsDiff,
 	}
@@ -145,6 +156,12 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%s/%s/%d", appID, envID, output.DeploymentNumber))
 
+	if d.Get("wait_for_deployment").(bool) {
+		if _, err := waitDeploymentCompleted(ctx, conn, appID, envID, output.DeploymentNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for AppConfig Deployment (%s) complete: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
 
@@ -158,15 +175,9 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	input := &appconfig.GetDeploymentInput{
-		ApplicationId:    aws.String(appID),
-		DeploymentNumber: aws.Int32(deploymentNum),
-		EnvironmentId:    aws.String(envID),
-	}
-
-	output, err := conn.GetDeployment(ctx, input)
+	output, err := findDeploymentByThreePartKey(ctx, conn, appID, envID, deploymentNum)
 
-	if !d.IsNewResource() && errs.IsA[*awstypes.ResourceNotFoundException](err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Appconfig Deployment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -176,10 +187,6 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	if output == nil {
-		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): empty response", d.Id())
-	}
-
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -217,6 +224,68 @@ func resourceDeploymentDelete(ctx context.Context, _ *schema.ResourceData, _ int
 	return diags
 }
 
+func findDeploymentByThreePartKey(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32) (*appconfig.GetDeploymentOutput, error) {
+	input := &appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(appID),
+		DeploymentNumber: aws.Int32(deploymentNum),
+		EnvironmentId:    aws.String(envID),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDeployment(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByThreePartKey(ctx, conn, appID, envID, deploymentNum)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+// waitDeploymentCompleted waits for the deployment, including any final bake time, to complete.
+func waitDeploymentCompleted(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32, timeout time.Duration) (*appconfig.GetDeploymentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DeploymentStateBaking, awstypes.DeploymentStateDeploying, awstypes.DeploymentStateValidating),
+		Target:     enum.Slice(awstypes.DeploymentStateComplete),
+		Refresh:    statusDeployment(ctx, conn, appID, envID, deploymentNum),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appconfig.GetDeploymentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func DeploymentParseID(id string) (string, string, int32, error) {
 	parts := strings.Split(id, "/")
 
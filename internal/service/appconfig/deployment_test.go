@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -53,9 +54,10 @@ func TestAccAppConfigDeployment_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
 			},
 		},
 	})
@@ -125,7 +127,7 @@ func TestAccAppConfigDeployment_predefinedStrategy(t *testing.T) {
 				// depending on the predefined deployment strategy,
 				// a waiter is not implemented for the resource;
 				// thus, we cannot guarantee the "state" value during import.
-				ImportStateVerifyIgnore: []string{names.AttrState},
+				ImportStateVerifyIgnore: []string{names.AttrState, "wait_for_deployment"},
 			},
 		},
 	})
@@ -156,6 +158,33 @@ func TestAccAppConfigDeployment_multiple(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigDeployment_waitForDeployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_appconfig_deployment.test"
+	resource2Name := "aws_appconfig_deployment.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_waitForDeployment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resource1Name),
+					resource.TestCheckResourceAttr(resource1Name, names.AttrState, string(awstypes.DeploymentStateComplete)),
+					resource.TestCheckResourceAttr(resource1Name, "wait_for_deployment", acctest.CtTrue),
+					testAccCheckDeploymentExists(ctx, resource2Name),
+					resource.TestCheckResourceAttr(resource2Name, names.AttrState, string(awstypes.DeploymentStateComplete)),
+					resource.TestCheckResourceAttr(resource2Name, "wait_for_deployment", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDeploymentExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -317,6 +346,45 @@ resource "aws_appconfig_deployment" "test"{
 `, rName, strategy))
 }
 
+func testAccDeploymentConfig_waitForDeployment(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_appconfig_environment" "test2" {
+  name           = "%[1]s-2"
+  application_id = aws_appconfig_application.test.id
+}
+
+resource "aws_appconfig_deployment_strategy" "test2" {
+  name                           = "%[1]s-2"
+  deployment_duration_in_minutes = 0
+  final_bake_time_in_minutes     = 1
+  growth_factor                  = 100
+  replicate_to                   = "NONE"
+}
+
+resource "aws_appconfig_deployment" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  configuration_version    = aws_appconfig_hosted_configuration_version.test.version_number
+  description              = %[1]q
+  deployment_strategy_id   = aws_appconfig_deployment_strategy.test2.id
+  environment_id           = aws_appconfig_environment.test.environment_id
+  wait_for_deployment      = true
+}
+
+resource "aws_appconfig_deployment" "test2" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  configuration_version    = aws_appconfig_hosted_configuration_version.test.version_number
+  description              = %[1]q
+  deployment_strategy_id   = aws_appconfig_deployment_strategy.test2.id
+  environment_id           = aws_appconfig_environment.test2.environment_id
+  wait_for_deployment      = true
+
+  depends_on = [aws_appconfig_deployment.test]
+}
+`, rName))
+}
+
 func testAccDeploymentConfig_multiple(rName string, n int) string {
 	return fmt.Sprintf(`
 resource "aws_appconfig_application" "test" {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -29,6 +30,7 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedConfigurationVersionCreate,
 		ReadWithoutTimeout:   resourceHostedConfigurationVersionRead,
+		UpdateWithoutTimeout: resourceHostedConfigurationVersionUpdate,
 		DeleteWithoutTimeout: resourceHostedConfigurationVersionDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -69,6 +71,11 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"latest_versions_to_keep": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"version_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -103,6 +110,12 @@ func resourceHostedConfigurationVersionCreate(ctx context.Context, d *schema.Res
 
 	d.SetId(fmt.Sprintf("%s/%s/%d", aws.ToString(output.ApplicationId), aws.ToString(output.ConfigurationProfileId), output.VersionNumber))
 
+	if v, ok := d.GetOk("latest_versions_to_keep"); ok {
+		if err := deleteOldHostedConfigurationVersions(ctx, conn, appID, profileID, output.VersionNumber, v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting old AppConfig Hosted Configuration Versions (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceHostedConfigurationVersionRead(ctx, d, meta)...)
 }
 
@@ -158,6 +171,26 @@ func resourceHostedConfigurationVersionRead(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+func resourceHostedConfigurationVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	if d.HasChange("latest_versions_to_keep") {
+		appID, confProfID, versionNumber, err := HostedConfigurationVersionParseID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if v, ok := d.GetOk("latest_versions_to_keep"); ok {
+			if err := deleteOldHostedConfigurationVersions(ctx, conn, appID, confProfID, versionNumber, v.(int)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting old AppConfig Hosted Configuration Versions (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceHostedConfigurationVersionRead(ctx, d, meta)...)
+}
+
 func resourceHostedConfigurationVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
@@ -185,6 +218,119 @@ func resourceHostedConfigurationVersionDelete(ctx context.Context, d *schema.Res
 	return diags
 }
 
+// deleteOldHostedConfigurationVersions deletes all but the latest versionsToKeep hosted configuration versions
+// of the specified configuration profile.
+// Only versions older than the version managed by this resource are candidates for deletion, so versions created
+// afterwards (e.g. by another resource) are never touched. Versions referenced by a deployment to any of the
+// application's environments are also retained.
+func deleteOldHostedConfigurationVersions(ctx context.Context, conn *appconfig.Client, appID, confProfID string, currentVersionNumber int32, versionsToKeep int) error {
+	input := &appconfig.ListHostedConfigurationVersionsInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(confProfID),
+	}
+	var versionNumbers []int32
+
+	pages := appconfig.NewListHostedConfigurationVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("listing AppConfig Hosted Configuration Versions: %w", err)
+		}
+
+		for _, v := range page.Items {
+			versionNumbers = append(versionNumbers, v.VersionNumber)
+		}
+	}
+
+	if len(versionNumbers) <= versionsToKeep {
+		return nil
+	}
+
+	slices.Sort(versionNumbers)
+	slices.Reverse(versionNumbers)
+
+	deployedVersions, err := findDeployedHostedConfigurationVersions(ctx, conn, appID, confProfID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, versionNumber := range versionNumbers[versionsToKeep:] {
+		if versionNumber >= currentVersionNumber {
+			continue
+		}
+
+		if _, ok := deployedVersions[strconv.Itoa(int(versionNumber))]; ok {
+			log.Printf("[DEBUG] Retaining AppConfig Hosted Configuration Version (%s/%s/%d): referenced by a deployment", appID, confProfID, versionNumber)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting AppConfig Hosted Configuration Version: %s/%s/%d", appID, confProfID, versionNumber)
+		_, err := conn.DeleteHostedConfigurationVersion(ctx, &appconfig.DeleteHostedConfigurationVersionInput{
+			ApplicationId:          aws.String(appID),
+			ConfigurationProfileId: aws.String(confProfID),
+			VersionNumber:          aws.Int32(versionNumber),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting AppConfig Hosted Configuration Version (%d): %w", versionNumber, err)
+		}
+	}
+
+	return nil
+}
+
+// findDeployedHostedConfigurationVersions returns the set of versions of the specified configuration profile
+// that are referenced by a deployment to any of the application's environments.
+// Deployment summaries identify the configuration profile by name only, so versions of other profiles with
+// the same name are conservatively treated as referenced.
+func findDeployedHostedConfigurationVersions(ctx context.Context, conn *appconfig.Client, appID, confProfID string) (map[string]struct{}, error) {
+	profile, err := conn.GetConfigurationProfile(ctx, &appconfig.GetConfigurationProfileInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(confProfID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading AppConfig Configuration Profile (%s): %w", confProfID, err)
+	}
+
+	environments, err := findEnvironmentsByApplication(ctx, conn, appID)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing AppConfig Environments (%s): %w", appID, err)
+	}
+
+	versions := make(map[string]struct{})
+
+	for _, environment := range environments {
+		pages := appconfig.NewListDeploymentsPaginator(conn, &appconfig.ListDeploymentsInput{
+			ApplicationId: aws.String(appID),
+			EnvironmentId: environment.Id,
+		})
+
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("listing AppConfig Deployments (%s/%s): %w", appID, aws.ToString(environment.Id), err)
+			}
+
+			for _, v := range page.Items {
+				if aws.ToString(v.ConfigurationName) == aws.ToString(profile.Name) {
+					versions[aws.ToString(v.ConfigurationVersion)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return versions, nil
+}
+
 func HostedConfigurationVersionParseID(id string) (string, string, int32, error) {
 	parts := strings.Split(id, "/")
 
@@ -78,6 +78,75 @@ func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_latestVersionsToKeep(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedConfigurationVersionConfig_latestVersionsToKeep(rName, "one", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_versions_to_keep", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccHostedConfigurationVersionConfig_latestVersionsToKeep(rName, "two", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccHostedConfigurationVersionConfig_latestVersionsToKeep(rName, "three", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_versions_to_keep", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct3),
+					testAccCheckHostedConfigurationVersionCount(ctx, resourceName, 1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"latest_versions_to_keep"},
+			},
+		},
+	})
+}
+
+func TestAccAppConfigHostedConfigurationVersion_latestVersionsToKeepRetainsDeployed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedConfigurationVersionConfig_latestVersionsToKeepDeployed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, "aws_appconfig_hosted_configuration_version.test"),
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_versions_to_keep", acctest.Ct1),
+					testAccCheckHostedConfigurationVersionCount(ctx, resourceName, 2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckHostedConfigurationVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
@@ -155,6 +224,32 @@ func testAccCheckHostedConfigurationVersionExists(ctx context.Context, resourceN
 	}
 }
 
+func testAccCheckHostedConfigurationVersionCount(ctx context.Context, resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
+
+		output, err := conn.ListHostedConfigurationVersions(ctx, &appconfig.ListHostedConfigurationVersionsInput{
+			ApplicationId:          aws.String(rs.Primary.Attributes[names.AttrApplicationID]),
+			ConfigurationProfileId: aws.String(rs.Primary.Attributes["configuration_profile_id"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.Items); got != expected {
+			return fmt.Errorf("AppConfig Hosted Configuration Version count = %d, want %d", got, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccHostedConfigurationVersionConfig_basic(rName string) string {
 	return acctest.ConfigCompose(
 		testAccConfigurationProfileConfig_name(rName),
@@ -172,3 +267,49 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionConfig_latestVersionsToKeep(rName, value string, latestVersionsToKeep int) string {
+	return acctest.ConfigCompose(
+		testAccConfigurationProfileConfig_name(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  content = jsonencode({
+    foo = %[2]q
+  })
+
+  description             = %[1]q
+  latest_versions_to_keep = %[3]d
+}
+`, rName, value, latestVersionsToKeep))
+}
+
+func testAccHostedConfigurationVersionConfig_latestVersionsToKeepDeployed(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_appconfig_deployment" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  configuration_version    = aws_appconfig_hosted_configuration_version.test.version_number
+  deployment_strategy_id   = aws_appconfig_deployment_strategy.test.id
+  environment_id           = aws_appconfig_environment.test.environment_id
+}
+
+resource "aws_appconfig_hosted_configuration_version" "test2" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  content = jsonencode({
+    foo = "baz"
+  })
+
+  description             = %[1]q
+  latest_versions_to_keep = 1
+
+  depends_on = [aws_appconfig_deployment.test]
+}
+`, rName))
+}
@@ -5,6 +5,7 @@ package appfabric
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -251,6 +252,13 @@ func waitConnectAppAuthorizationCreated(ctx context.Context, conn *appfabric.Cli
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if out, ok := outputRaw.(*awstypes.AppAuthorization); ok {
+		switch out.Status {
+		case awstypes.AppAuthorizationStatusConnectionValidationFailed:
+			tfresource.SetLastError(err, errors.New("the application rejected the authorization; verify the auth_request code and redirect_uri or the app authorization credential"))
+		case awstypes.AppAuthorizationStatusTokenAutoRotationFailed:
+			tfresource.SetLastError(err, errors.New("the application token could not be rotated; re-authorize the app authorization"))
+		}
+
 		return out, err
 	}
 
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appintegrations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appintegrations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_appintegrations_data_integration_associations", name="Data Integration Associations")
+func dataSourceDataIntegrationAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDataIntegrationAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"data_integration_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_integration_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_integration_association_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"data_integration_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceDataIntegrationAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	id := d.Get("data_integration_identifier").(string)
+	input := &appintegrations.ListDataIntegrationAssociationsInput{
+		DataIntegrationIdentifier: aws.String(id),
+	}
+
+	associations, err := findDataIntegrationAssociations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppIntegrations Data Integration (%s) Associations: %s", id, err)
+	}
+
+	d.SetId(id)
+	if err := d.Set("data_integration_associations", flattenDataIntegrationAssociationSummaries(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_integration_associations: %s", err)
+	}
+	d.Set("data_integration_identifier", id)
+
+	return diags
+}
+
+func findDataIntegrationAssociations(ctx context.Context, conn *appintegrations.Client, input *appintegrations.ListDataIntegrationAssociationsInput) ([]awstypes.DataIntegrationAssociationSummary, error) {
+	var output []awstypes.DataIntegrationAssociationSummary
+
+	for {
+		page, err := conn.ListDataIntegrationAssociations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DataIntegrationAssociations...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func flattenDataIntegrationAssociationSummaries(apiObjects []awstypes.DataIntegrationAssociationSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"client_id":                        aws.ToString(apiObject.ClientId),
+			"data_integration_arn":             aws.ToString(apiObject.DataIntegrationArn),
+			"data_integration_association_arn": aws.ToString(apiObject.DataIntegrationAssociationArn),
+		})
+	}
+
+	return tfList
+}
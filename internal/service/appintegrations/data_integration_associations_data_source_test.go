@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations_test
+
+import (
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppIntegrationsDataIntegrationAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	dataSourceName := "data.aws_appintegrations_data_integration_associations.test"
+
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppIntegrationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIntegrationAssociationsDataSourceConfig_basic(rName, description, sourceUri, firstExecutionFrom),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_integration_identifier", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "data_integration_associations.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataIntegrationAssociationsDataSourceConfig_basic(rName, description, sourceUri, firstExecutionFrom string) string {
+	return acctest.ConfigCompose(testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), `
+data "aws_appintegrations_data_integration_associations" "test" {
+  data_integration_identifier = aws_appintegrations_data_integration.test.arn
+}
+`)
+}
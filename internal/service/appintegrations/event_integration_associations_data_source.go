@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appintegrations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appintegrations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_appintegrations_event_integration_associations", name="Event Integration Associations")
+func dataSourceEventIntegrationAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventIntegrationAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_integration_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_association_metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"eventbridge_rule_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_integration_association_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_integration_association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"event_integration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceEventIntegrationAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	name := d.Get("event_integration_name").(string)
+	input := &appintegrations.ListEventIntegrationAssociationsInput{
+		EventIntegrationName: aws.String(name),
+	}
+
+	associations, err := findEventIntegrationAssociations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppIntegrations Event Integration (%s) Associations: %s", name, err)
+	}
+
+	d.SetId(name)
+	if err := d.Set("event_integration_associations", flattenEventIntegrationAssociations(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting event_integration_associations: %s", err)
+	}
+	d.Set("event_integration_name", name)
+
+	return diags
+}
+
+func findEventIntegrationAssociations(ctx context.Context, conn *appintegrations.Client, input *appintegrations.ListEventIntegrationAssociationsInput) ([]awstypes.EventIntegrationAssociation, error) {
+	var output []awstypes.EventIntegrationAssociation
+
+	for {
+		page, err := conn.ListEventIntegrationAssociations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EventIntegrationAssociations...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func flattenEventIntegrationAssociations(apiObjects []awstypes.EventIntegrationAssociation) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"client_association_metadata":       apiObject.ClientAssociationMetadata,
+			"client_id":                         aws.ToString(apiObject.ClientId),
+			"eventbridge_rule_name":             aws.ToString(apiObject.EventBridgeRuleName),
+			"event_integration_association_arn": aws.ToString(apiObject.EventIntegrationAssociationArn),
+			"event_integration_association_id":  aws.ToString(apiObject.EventIntegrationAssociationId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations_test
+
+import (
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppIntegrationsEventIntegrationAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_appintegrations_event_integration.test"
+	dataSourceName := "data.aws_appintegrations_event_integration_associations.test"
+
+	key := "EVENT_BRIDGE_PARTNER_EVENT_SOURCE_NAME"
+	sourceName := os.Getenv(key)
+	if sourceName == "" {
+		sourceName = "aws.partner/examplepartner.com"
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AppIntegrationsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppIntegrationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventIntegrationAssociationsDataSourceConfig_basic(rName, sourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "event_integration_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "event_integration_associations.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccEventIntegrationAssociationsDataSourceConfig_basic(rName, sourceName string) string {
+	return acctest.ConfigCompose(testAccEventIntegrationDataSourceConfig_base(rName, sourceName), `
+data "aws_appintegrations_event_integration_associations" "test" {
+  event_integration_name = aws_appintegrations_event_integration.test.name
+}
+`)
+}
@@ -24,12 +24,22 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceDataIntegrationAssociations,
+			TypeName: "aws_appintegrations_data_integration_associations",
+			Name:     "Data Integration Associations",
+		},
 		{
 			Factory:  DataSourceEventIntegration,
 			TypeName: "aws_appintegrations_event_integration",
 			Name:     "Event Integration",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceEventIntegrationAssociations,
+			TypeName: "aws_appintegrations_event_integration_associations",
+			Name:     "Event Integration Associations",
+		},
 	}
 }
 
@@ -17,6 +17,7 @@ func TestAccAppMesh_serial(t *testing.T) {
 			acctest.CtBasic:                testAccGatewayRoute_basic,
 			acctest.CtDisappears:           testAccGatewayRoute_disappears,
 			"grpcRoute":                    testAccGatewayRoute_grpcRoute,
+			"grpcRouteRewrite":             testAccGatewayRoute_grpcRouteRewrite,
 			"grpcRouteTargetPort":          testAccGatewayRoute_grpcRouteTargetPort,
 			"grpcRouteWithPort":            testAccGatewayRoute_grpcRouteWithPort,
 			"httpRoute":                    testAccGatewayRoute_httpRoute,
@@ -466,6 +466,31 @@ func resourceGatewayRouteSpecSchema() *schema.Schema {
 												},
 											},
 										},
+										"rewrite": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MinItems: 1,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"hostname": {
+														Type:     schema.TypeList,
+														Required: true,
+														MinItems: 1,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"default_target_hostname": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED"}, false),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -772,6 +797,10 @@ func expandGRPCGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewayRoute
 			routeAction.Target = expandGatewayRouteTarget(vRouteTarget)
 		}
 
+		if vRouteRewrite, ok := mRouteAction["rewrite"].([]interface{}); ok {
+			routeAction.Rewrite = expandGRPCGatewayRouteRewrite(vRouteRewrite)
+		}
+
 		route.Action = routeAction
 	}
 
@@ -794,6 +823,25 @@ func expandGRPCGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewayRoute
 	return route
 }
 
+func expandGRPCGatewayRouteRewrite(vGrpcRouteRewrite []interface{}) *appmesh.GrpcGatewayRouteRewrite {
+	if len(vGrpcRouteRewrite) == 0 || vGrpcRouteRewrite[0] == nil {
+		return nil
+	}
+	mRouteRewrite := vGrpcRouteRewrite[0].(map[string]interface{})
+	routeRewrite := &appmesh.GrpcGatewayRouteRewrite{}
+
+	if vRouteHostnameRewrite, ok := mRouteRewrite["hostname"].([]interface{}); ok && len(vRouteHostnameRewrite) > 0 && vRouteHostnameRewrite[0] != nil {
+		mRouteHostnameRewrite := vRouteHostnameRewrite[0].(map[string]interface{})
+		routeHostnameRewrite := &appmesh.GatewayRouteHostnameRewrite{}
+		if vDefaultTargetHostname, ok := mRouteHostnameRewrite["default_target_hostname"].(string); ok && vDefaultTargetHostname != "" {
+			routeHostnameRewrite.DefaultTargetHostname = aws.String(vDefaultTargetHostname)
+		}
+		routeRewrite.Hostname = routeHostnameRewrite
+	}
+
+	return routeRewrite
+}
+
 func expandHTTPGatewayRouteRewrite(vHttpRouteRewrite []interface{}) *appmesh.HttpGatewayRouteRewrite {
 	if len(vHttpRouteRewrite) == 0 || vHttpRouteRewrite[0] == nil {
 		return nil
@@ -1044,6 +1092,10 @@ func flattenGRPCGatewayRoute(grpcRoute *appmesh.GrpcGatewayRoute) []interface{}
 			names.AttrTarget: flattenGatewayRouteTarget(routeAction.Target),
 		}
 
+		if routeRewrite := routeAction.Rewrite; routeRewrite != nil {
+			mRouteAction["rewrite"] = flattenGRPCGatewayRouteRewrite(routeRewrite)
+		}
+
 		mGrpcRoute[names.AttrAction] = []interface{}{mRouteAction}
 	}
 
@@ -1158,6 +1210,23 @@ func flattenHTTPGatewayRouteMatch(routeMatch *appmesh.HttpGatewayRouteMatch) []i
 	return []interface{}{mRouteMatch}
 }
 
+func flattenGRPCGatewayRouteRewrite(routeRewrite *appmesh.GrpcGatewayRouteRewrite) []interface{} {
+	if routeRewrite == nil {
+		return []interface{}{}
+	}
+
+	mRouteRewrite := map[string]interface{}{}
+
+	if rewriteHostname := routeRewrite.Hostname; rewriteHostname != nil {
+		mRewriteHostname := map[string]interface{}{
+			"default_target_hostname": aws.StringValue(rewriteHostname.DefaultTargetHostname),
+		}
+		mRouteRewrite["hostname"] = []interface{}{mRewriteHostname}
+	}
+
+	return []interface{}{mRouteRewrite}
+}
+
 func flattenHTTPGatewayRouteRewrite(routeRewrite *appmesh.HttpGatewayRouteRewrite) []interface{} {
 	if routeRewrite == nil {
 		return []interface{}{}
@@ -179,6 +179,54 @@ func testAccGatewayRoute_grpcRoute(t *testing.T) {
 	})
 }
 
+func testAccGatewayRoute_grpcRouteRewrite(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appmesh.GatewayRouteData
+	resourceName := "aws_appmesh_gateway_route.test"
+	vs1ResourceName := "aws_appmesh_virtual_service.test.0"
+	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appmesh.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayRouteConfig_grpcRouteRewrite(meshName, vgName, grName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGatewayRouteExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "spec.0.grpc_route.0.action.0.target.0.virtual_service.0.virtual_service_name", vs1ResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.0.hostname.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.0.hostname.0.default_target_hostname", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.0.service_name", "test1"),
+				),
+			},
+			{
+				Config: testAccGatewayRouteConfig_grpcRouteRewrite(meshName, vgName, grName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGatewayRouteExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.0.hostname.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.0.hostname.0.default_target_hostname", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccGatewayRouteImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccGatewayRoute_grpcRouteWithPort(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v appmesh.GatewayRouteData
@@ -1492,6 +1540,38 @@ resource "aws_appmesh_gateway_route" "test" {
 `, grName))
 }
 
+func testAccGatewayRouteConfig_grpcRouteRewrite(meshName, vgName, grName, defaultTargetHostname string) string {
+	return acctest.ConfigCompose(testAccGatewayRouteConfig_base(meshName, vgName, "grpc"), fmt.Sprintf(`
+resource "aws_appmesh_gateway_route" "test" {
+  name                 = %[1]q
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_gateway_name = aws_appmesh_virtual_gateway.test.name
+
+  spec {
+    grpc_route {
+      action {
+        target {
+          virtual_service {
+            virtual_service_name = aws_appmesh_virtual_service.test[0].name
+          }
+        }
+
+        rewrite {
+          hostname {
+            default_target_hostname = %[2]q
+          }
+        }
+      }
+
+      match {
+        service_name = "test1"
+      }
+    }
+  }
+}
+`, grName, defaultTargetHostname))
+}
+
 func testAccGatewayRouteConfig_grpcRouteTargetPort(meshName, vgName, grName string) string {
 	return acctest.ConfigCompose(
 		testAccGatewayRouteConfig_base(meshName, vgName, "grpc"),
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -144,6 +145,11 @@ func testAccMesh_serviceDiscovery(t *testing.T) {
 			},
 			{
 				Config: testAccMeshConfig_serviceDiscovery(rName, "IPv4_PREFERRED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceMeshExists(ctx, resourceName, &mesh),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
@@ -152,6 +158,11 @@ func testAccMesh_serviceDiscovery(t *testing.T) {
 			},
 			{
 				Config: testAccMeshConfig_serviceDiscovery(rName, "IPv4_ONLY"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceMeshExists(ctx, resourceName, &mesh),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
@@ -160,6 +171,11 @@ func testAccMesh_serviceDiscovery(t *testing.T) {
 			},
 			{
 				Config: testAccMeshConfig_serviceDiscovery(rName, "IPv6_ONLY"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceMeshExists(ctx, resourceName, &mesh),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
@@ -13,4 +13,5 @@ var (
 	ResourceControl                              = newResourceControl
 	ResourceFramework                            = newResourceFramework
 	ResourceFrameworkShare                       = newResourceFrameworkShare
+	ResourceFrameworkShareAccepter               = newResourceFrameworkShareAccepter
 )
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource
+func newResourceFrameworkShareAccepter(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceFrameworkShareAccepter{}, nil
+}
+
+const (
+	ResNameFrameworkShareAccepter = "FrameworkShareAccepter"
+)
+
+type resourceFrameworkShareAccepter struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceFrameworkShareAccepter) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_auditmanager_framework_share_accepter"
+}
+
+func (r *resourceFrameworkShareAccepter) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"framework_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"framework_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"share_request_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_account": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceFrameworkShareAccepter) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var plan resourceFrameworkShareAccepterData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := auditmanager.UpdateAssessmentFrameworkShareInput{
+		Action:      awstypes.ShareRequestActionAccept,
+		RequestId:   aws.String(plan.ShareRequestID.ValueString()),
+		RequestType: awstypes.ShareRequestTypeReceived,
+	}
+	out, err := conn.UpdateAssessmentFrameworkShare(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameFrameworkShareAccepter, plan.ShareRequestID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = plan.ShareRequestID
+	if out != nil && out.AssessmentFrameworkShareRequest != nil {
+		state.refreshFromOutput(ctx, out.AssessmentFrameworkShareRequest)
+	} else {
+		// Fall back to reading the received share request when the update
+		// response does not include it.
+		share, err := FindReceivedFrameworkShareByID(ctx, conn, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.AuditManager, create.ErrActionCreating, ResNameFrameworkShareAccepter, plan.ShareRequestID.String(), nil),
+				err.Error(),
+			)
+			return
+		}
+		state.refreshFromOutput(ctx, share)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceFrameworkShareAccepter) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var state resourceFrameworkShareAccepterData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindReceivedFrameworkShareByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, ResNameFrameworkShareAccepter, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is a no-op. Changing share_request_id will result in a destroy and replace.
+func (r *resourceFrameworkShareAccepter) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete removes the received share request. The custom framework created in the
+// recipient account by accepting the request is retained.
+func (r *resourceFrameworkShareAccepter) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().AuditManagerClient(ctx)
+
+	var state resourceFrameworkShareAccepterData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := auditmanager.DeleteAssessmentFrameworkShareInput{
+		RequestId:   aws.String(state.ID.ValueString()),
+		RequestType: awstypes.ShareRequestTypeReceived,
+	}
+	_, err := conn.DeleteAssessmentFrameworkShare(ctx, &in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionDeleting, ResNameFrameworkShareAccepter, state.ID.String(), nil),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceFrameworkShareAccepter) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("share_request_id"), req.ID)...)
+}
+
+func FindReceivedFrameworkShareByID(ctx context.Context, conn *auditmanager.Client, id string) (*awstypes.AssessmentFrameworkShareRequest, error) {
+	in := &auditmanager.ListAssessmentFrameworkShareRequestsInput{
+		RequestType: awstypes.ShareRequestTypeReceived,
+	}
+	pages := auditmanager.NewListAssessmentFrameworkShareRequestsPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, share := range page.AssessmentFrameworkShareRequests {
+			if id == aws.ToString(share.Id) {
+				return &share, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type resourceFrameworkShareAccepterData struct {
+	FrameworkID    types.String `tfsdk:"framework_id"`
+	FrameworkName  types.String `tfsdk:"framework_name"`
+	ID             types.String `tfsdk:"id"`
+	ShareRequestID types.String `tfsdk:"share_request_id"`
+	SourceAccount  types.String `tfsdk:"source_account"`
+	Status         types.String `tfsdk:"status"`
+}
+
+// refreshFromOutput writes state data from an AWS response object
+func (rd *resourceFrameworkShareAccepterData) refreshFromOutput(ctx context.Context, out *awstypes.AssessmentFrameworkShareRequest) {
+	if out == nil {
+		return
+	}
+
+	rd.FrameworkID = flex.StringToFramework(ctx, out.FrameworkId)
+	rd.FrameworkName = flex.StringToFramework(ctx, out.FrameworkName)
+	rd.ID = flex.StringToFramework(ctx, out.Id)
+	rd.ShareRequestID = flex.StringToFramework(ctx, out.Id)
+	rd.SourceAccount = flex.StringToFramework(ctx, out.SourceAccount)
+	rd.Status = flex.StringValueToFramework(ctx, out.Status)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAuditManagerFrameworkShareAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var frameworkShare types.AssessmentFrameworkShareRequest
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_framework_share_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionHasService(t, names.AuditManagerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AuditManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesPlusProvidersAlternate(ctx, t, &providers),
+		CheckDestroy:             testAccCheckFrameworkShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkShareAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFrameworkShareAccepterExists(ctx, resourceName, &frameworkShare, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					resource.TestCheckResourceAttrPair(resourceName, "share_request_id", "aws_auditmanager_framework_share.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "source_account", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "framework_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFrameworkShareAccepterExists(ctx context.Context, name string, frameworkShare *types.AssessmentFrameworkShareRequest, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameFrameworkShareAccepter, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameFrameworkShareAccepter, name, errors.New("not set"))
+		}
+
+		conn := providerF().Meta().(*conns.AWSClient).AuditManagerClient(ctx)
+		resp, err := tfauditmanager.FindReceivedFrameworkShareByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.AuditManager, create.ErrActionCheckingExistence, tfauditmanager.ResNameFrameworkShareAccepter, rs.Primary.ID, err)
+		}
+
+		*frameworkShare = *resp
+
+		return nil
+	}
+}
+
+func testAccFrameworkShareAccepterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		testAccFrameworkShareConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_auditmanager_framework_share" "test" {
+  destination_account = data.aws_caller_identity.current.account_id
+  destination_region  = %[1]q
+  framework_id        = aws_auditmanager_framework.test.id
+}
+
+resource "aws_auditmanager_framework_share_accepter" "test" {
+  provider = "awsalternate"
+
+  share_request_id = aws_auditmanager_framework_share.test.id
+}
+`, acctest.AlternateRegion()))
+}
@@ -60,6 +60,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newResourceFrameworkShare,
 		},
+		{
+			Factory: newResourceFrameworkShareAccepter,
+		},
 		{
 			Factory: newResourceOrganizationAdminAccountRegistration,
 		},
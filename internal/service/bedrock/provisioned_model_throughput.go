@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/bedrock"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -63,6 +64,13 @@ func (r *resourceProvisionedModelThroughput) Schema(ctx context.Context, request
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"commitment_expiration_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrID: framework.IDAttribute(),
 			"model_arn": schema.StringAttribute{
 				Required:   true,
@@ -184,7 +192,31 @@ func (r *resourceProvisionedModelThroughput) Delete(ctx context.Context, request
 
 	conn := r.Meta().BedrockClient(ctx)
 
-	_, err := conn.DeleteProvisionedModelThroughput(ctx, &bedrock.DeleteProvisionedModelThroughputInput{
+	output, err := findProvisionedModelThroughputByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Provisioned Throughput purchased with a commitment can't be deleted until the commitment term ends.
+	if v := output.CommitmentExpirationTime; v != nil && time.Now().Before(aws.ToTime(v)) {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("deleting Bedrock Provisioned Model Throughput (%s)", data.ID.ValueString()),
+			fmt.Sprintf("Provisioned Throughput has an active %s commitment that expires at %s and can't be deleted before then. "+
+				"To stop managing it with Terraform, remove it from state with `terraform state rm`.",
+				output.CommitmentDuration, aws.ToTime(v).Format(time.RFC3339)),
+		)
+
+		return
+	}
+
+	_, err = conn.DeleteProvisionedModelThroughput(ctx, &bedrock.DeleteProvisionedModelThroughputInput{
 		ProvisionedModelId: fwflex.StringFromFramework(ctx, data.ID),
 	})
 
@@ -260,15 +292,16 @@ func waitProvisionedModelThroughputCreated(ctx context.Context, conn *bedrock.Cl
 }
 
 type provisionedModelThroughputResourceModel struct {
-	CommitmentDuration   fwtypes.StringEnum[awstypes.CommitmentDuration] `tfsdk:"commitment_duration"`
-	ID                   types.String                                    `tfsdk:"id"`
-	ModelARN             fwtypes.ARN                                     `tfsdk:"model_arn"`
-	ModelUnits           types.Int64                                     `tfsdk:"model_units"`
-	ProvisionedModelARN  types.String                                    `tfsdk:"provisioned_model_arn"`
-	ProvisionedModelName types.String                                    `tfsdk:"provisioned_model_name"`
-	Tags                 types.Map                                       `tfsdk:"tags"`
-	TagsAll              types.Map                                       `tfsdk:"tags_all"`
-	Timeouts             timeouts.Value                                  `tfsdk:"timeouts"`
+	CommitmentDuration       fwtypes.StringEnum[awstypes.CommitmentDuration] `tfsdk:"commitment_duration"`
+	CommitmentExpirationTime timetypes.RFC3339                               `tfsdk:"commitment_expiration_time"`
+	ID                       types.String                                    `tfsdk:"id"`
+	ModelARN                 fwtypes.ARN                                     `tfsdk:"model_arn"`
+	ModelUnits               types.Int64                                     `tfsdk:"model_units"`
+	ProvisionedModelARN      types.String                                    `tfsdk:"provisioned_model_arn"`
+	ProvisionedModelName     types.String                                    `tfsdk:"provisioned_model_name"`
+	Tags                     types.Map                                       `tfsdk:"tags"`
+	TagsAll                  types.Map                                       `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                  `tfsdk:"timeouts"`
 }
 
 func (data *provisionedModelThroughputResourceModel) InitFromID() error {
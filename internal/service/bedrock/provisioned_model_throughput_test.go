@@ -38,6 +38,7 @@ func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "commitment_duration", "OneMonth"),
+					resource.TestCheckResourceAttrSet(resourceName, "commitment_expiration_time"),
 					resource.TestCheckResourceAttrSet(resourceName, "model_arn"),
 					resource.TestCheckResourceAttr(resourceName, "model_units", acctest.Ct1),
 					resource.TestCheckResourceAttrSet(resourceName, "provisioned_model_arn"),
@@ -62,7 +63,7 @@ func testAccCheckProvisionedModelThroughputDestroy(ctx context.Context) resource
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_bedrock_custom_model" {
+			if rs.Type != "aws_bedrock_provisioned_model_throughput" {
 				continue
 			}
 
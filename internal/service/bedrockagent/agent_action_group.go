@@ -124,7 +124,8 @@ func (r *agentActionGroupResource) Schema(ctx context.Context, request resource.
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"payload": schema.StringAttribute{
-							Optional: true,
+							CustomType: fwtypes.JSONOrYAMLType,
+							Optional:   true,
 							Validators: []validator.String{
 								stringvalidator.ConflictsWith(
 									path.MatchRelative().AtParent().AtName("s3"),
@@ -469,7 +470,7 @@ func (m actionGroupExecutorModel) Expand(ctx context.Context) (result any, diags
 }
 
 type apiSchemaModel struct {
-	Payload types.String                                       `tfsdk:"payload"`
+	Payload fwtypes.JSONOrYAML                                 `tfsdk:"payload"`
 	S3      fwtypes.ListNestedObjectValueOf[s3IdentifierModel] `tfsdk:"s3"`
 }
 
@@ -568,11 +569,11 @@ func flattenAPISchema(ctx context.Context, apiObject awstypes.APISchema) fwtypes
 
 	switch v := apiObject.(type) {
 	case *awstypes.APISchemaMemberPayload:
-		apiSchemaData.Payload = fwflex.StringValueToFramework(ctx, v.Value)
+		apiSchemaData.Payload = fwtypes.JSONOrYAMLValue(v.Value)
 		apiSchemaData.S3 = fwtypes.NewListNestedObjectValueOfNull[s3IdentifierModel](ctx)
 
 	case *awstypes.APISchemaMemberS3:
-		apiSchemaData.Payload = types.StringNull()
+		apiSchemaData.Payload = fwtypes.JSONOrYAMLNull()
 		apiSchemaData.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3IdentifierModel{
 			S3BucketName: fwflex.StringToFramework(ctx, v.Value.S3BucketName),
 			S3ObjectKey:  fwflex.StringToFramework(ctx, v.Value.S3ObjectKey),
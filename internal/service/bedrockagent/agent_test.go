@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -132,6 +133,66 @@ func TestAccBedrockAgentAgent_update(t *testing.T) {
 	})
 }
 
+func TestAccBedrockAgentAgent_promptOverrideConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+	var v awstypes.Agent
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig_promptOverrideConfiguration(rName, "anthropic.claude-v2", 2048, 0, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prompt_override_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "prompt_override_configuration.0.prompt_configurations.#", acctest.Ct4),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "prompt_override_configuration.0.prompt_configurations.*", map[string]string{
+						"inference_configuration.#":             acctest.Ct1,
+						"inference_configuration.0.max_length":  "2048",
+						"inference_configuration.0.temperature": acctest.Ct0,
+						"inference_configuration.0.top_p":       acctest.Ct1,
+						"parser_mode":                           "DEFAULT",
+						"prompt_creation_mode":                  "OVERRIDDEN",
+						"prompt_state":                          "ENABLED",
+						"prompt_type":                           "ORCHESTRATION",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_resource_in_use_check"},
+			},
+			{
+				Config: testAccAgentConfig_promptOverrideConfiguration(rName, "anthropic.claude-v2", 1024, 0.5, 0.9),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prompt_override_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "prompt_override_configuration.0.prompt_configurations.#", acctest.Ct4),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "prompt_override_configuration.0.prompt_configurations.*", map[string]string{
+						"inference_configuration.#":             acctest.Ct1,
+						"inference_configuration.0.max_length":  "1024",
+						"inference_configuration.0.temperature": "0.5",
+						"inference_configuration.0.top_p":       "0.9",
+						"prompt_type":                           "ORCHESTRATION",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBedrockAgentAgent_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -419,3 +480,89 @@ resource "aws_bedrockagent_agent" "test" {
 }
 `, rName, model, desc))
 }
+
+func testAccAgentConfig_promptOverrideConfiguration(rName, model string, maxLength int, temperature, topP float64) string {
+	return acctest.ConfigCompose(testAccAgent_base(rName, model), fmt.Sprintf(`
+resource "aws_bedrockagent_agent" "test" {
+  agent_name                  = %[1]q
+  agent_resource_role_arn     = aws_iam_role.test_agent.arn
+  idle_session_ttl_in_seconds = 500
+  instruction                 = file("${path.module}/test-fixtures/instruction.txt")
+  foundation_model            = %[2]q
+  skip_resource_in_use_check  = true
+
+  prompt_override_configuration {
+    prompt_configurations = [
+      {
+        base_prompt_template = file("${path.module}/test-fixtures/pre-processing.txt")
+        inference_configuration = [
+          {
+            max_length     = 2048
+            stop_sequences = ["Human:"]
+            temperature    = 0
+            top_k          = 250
+            top_p          = 1
+          },
+        ]
+        parser_mode          = "DEFAULT"
+        prompt_creation_mode = "OVERRIDDEN"
+        prompt_state         = "ENABLED"
+        prompt_type          = "PRE_PROCESSING"
+      },
+      {
+        base_prompt_template = file("${path.module}/test-fixtures/knowledge-base-response-generation.txt")
+        inference_configuration = [
+          {
+            max_length     = 2048
+            stop_sequences = ["Human:"]
+            temperature    = 0
+            top_k          = 250
+            top_p          = 1
+          },
+        ]
+        parser_mode          = "DEFAULT"
+        prompt_creation_mode = "OVERRIDDEN"
+        prompt_state         = "ENABLED"
+        prompt_type          = "KNOWLEDGE_BASE_RESPONSE_GENERATION"
+      },
+      {
+        base_prompt_template = file("${path.module}/test-fixtures/orchestration.txt")
+        inference_configuration = [
+          {
+            max_length = %[3]d
+            stop_sequences = [
+              "</function_call>",
+              "</answer>",
+              "</error>",
+            ]
+            temperature = %[4]g
+            top_k       = 250
+            top_p       = %[5]g
+          },
+        ]
+        parser_mode          = "DEFAULT"
+        prompt_creation_mode = "OVERRIDDEN"
+        prompt_state         = "ENABLED"
+        prompt_type          = "ORCHESTRATION"
+      },
+      {
+        base_prompt_template = file("${path.module}/test-fixtures/post-processing.txt")
+        inference_configuration = [
+          {
+            max_length     = 2048
+            stop_sequences = ["Human:"]
+            temperature    = 0
+            top_k          = 250
+            top_p          = 1
+          },
+        ]
+        parser_mode          = "DEFAULT"
+        prompt_creation_mode = "OVERRIDDEN"
+        prompt_state         = "DISABLED"
+        prompt_type          = "POST_PROCESSING"
+      },
+    ]
+  }
+}
+`, rName, model, maxLength, temperature, topP))
+}
@@ -24,6 +24,9 @@ func TestAccBedrockAgent_serial(t *testing.T) {
 			acctest.CtBasic:      testAccDataSource_basic,
 			acctest.CtDisappears: testAccDataSource_disappears,
 			"full":               testAccDataSource_full,
+			"fullHierarchical":   testAccDataSource_fullHierarchical,
+			"fullSemantic":       testAccDataSource_fullSemantic,
+			"parsing":            testAccDataSource_parsing,
 			"update":             testAccDataSource_update,
 		},
 	}
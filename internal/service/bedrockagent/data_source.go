@@ -224,6 +224,159 @@ func (r *dataSourceResource) Schema(ctx context.Context, request resource.Schema
 											},
 										},
 									},
+									"hierarchical_chunking_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[hierarchicalChunkingConfigurationModel](ctx),
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"overlap_tokens": schema.Int64Attribute{
+													Required: true,
+													PlanModifiers: []planmodifier.Int64{
+														int64planmodifier.RequiresReplace(),
+													},
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"level_configuration": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[hierarchicalChunkingLevelConfigurationModel](ctx),
+													PlanModifiers: []planmodifier.List{
+														listplanmodifier.RequiresReplace(),
+													},
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeBetween(2, 2),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"max_tokens": schema.Int64Attribute{
+																Required: true,
+																PlanModifiers: []planmodifier.Int64{
+																	int64planmodifier.RequiresReplace(),
+																},
+																Validators: []validator.Int64{
+																	int64validator.Between(1, 8192),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"semantic_chunking_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[semanticChunkingConfigurationModel](ctx),
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"breakpoint_percentile_threshold": schema.Int64Attribute{
+													Required: true,
+													PlanModifiers: []planmodifier.Int64{
+														int64planmodifier.RequiresReplace(),
+													},
+													Validators: []validator.Int64{
+														int64validator.Between(50, 99),
+													},
+												},
+												"buffer_size": schema.Int64Attribute{
+													Required: true,
+													PlanModifiers: []planmodifier.Int64{
+														int64planmodifier.RequiresReplace(),
+													},
+													Validators: []validator.Int64{
+														int64validator.Between(0, 1),
+													},
+												},
+												"max_tokens": schema.Int64Attribute{
+													Required: true,
+													PlanModifiers: []planmodifier.Int64{
+														int64planmodifier.RequiresReplace(),
+													},
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"parsing_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[parsingConfigurationModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"parsing_strategy": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ParsingStrategy](),
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"bedrock_foundation_model_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[bedrockFoundationModelConfigurationModel](ctx),
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"model_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.RequiresReplace(),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"parsing_prompt": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[parsingPromptModel](ctx),
+													PlanModifiers: []planmodifier.List{
+														listplanmodifier.RequiresReplace(),
+													},
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"parsing_prompt_text": schema.StringAttribute{
+																Required: true,
+																PlanModifiers: []planmodifier.String{
+																	stringplanmodifier.RequiresReplace(),
+																},
+																Validators: []validator.String{
+																	stringvalidator.LengthBetween(1, 10000),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -508,14 +661,46 @@ type serverSideEncryptionConfigurationModel struct {
 
 type vectorIngestionConfigurationModel struct {
 	ChunkingConfiguration fwtypes.ListNestedObjectValueOf[chunkingConfigurationModel] `tfsdk:"chunking_configuration"`
+	ParsingConfiguration  fwtypes.ListNestedObjectValueOf[parsingConfigurationModel]  `tfsdk:"parsing_configuration"`
 }
 
 type chunkingConfigurationModel struct {
-	ChunkingStrategy               fwtypes.StringEnum[awstypes.ChunkingStrategy]                        `tfsdk:"chunking_strategy"`
-	FixedSizeChunkingConfiguration fwtypes.ListNestedObjectValueOf[fixedSizeChunkingConfigurationModel] `tfsdk:"fixed_size_chunking_configuration"`
+	ChunkingStrategy                  fwtypes.StringEnum[awstypes.ChunkingStrategy]                           `tfsdk:"chunking_strategy"`
+	FixedSizeChunkingConfiguration    fwtypes.ListNestedObjectValueOf[fixedSizeChunkingConfigurationModel]    `tfsdk:"fixed_size_chunking_configuration"`
+	HierarchicalChunkingConfiguration fwtypes.ListNestedObjectValueOf[hierarchicalChunkingConfigurationModel] `tfsdk:"hierarchical_chunking_configuration"`
+	SemanticChunkingConfiguration     fwtypes.ListNestedObjectValueOf[semanticChunkingConfigurationModel]     `tfsdk:"semantic_chunking_configuration"`
 }
 
 type fixedSizeChunkingConfigurationModel struct {
 	MaxTokens         types.Int64 `tfsdk:"max_tokens"`
 	OverlapPercentage types.Int64 `tfsdk:"overlap_percentage"`
 }
+
+type hierarchicalChunkingConfigurationModel struct {
+	LevelConfigurations fwtypes.ListNestedObjectValueOf[hierarchicalChunkingLevelConfigurationModel] `tfsdk:"level_configuration"`
+	OverlapTokens       types.Int64                                                                  `tfsdk:"overlap_tokens"`
+}
+
+type hierarchicalChunkingLevelConfigurationModel struct {
+	MaxTokens types.Int64 `tfsdk:"max_tokens"`
+}
+
+type semanticChunkingConfigurationModel struct {
+	BreakpointPercentileThreshold types.Int64 `tfsdk:"breakpoint_percentile_threshold"`
+	BufferSize                    types.Int64 `tfsdk:"buffer_size"`
+	MaxTokens                     types.Int64 `tfsdk:"max_tokens"`
+}
+
+type parsingConfigurationModel struct {
+	BedrockFoundationModelConfiguration fwtypes.ListNestedObjectValueOf[bedrockFoundationModelConfigurationModel] `tfsdk:"bedrock_foundation_model_configuration"`
+	ParsingStrategy                     fwtypes.StringEnum[awstypes.ParsingStrategy]                              `tfsdk:"parsing_strategy"`
+}
+
+type bedrockFoundationModelConfigurationModel struct {
+	ModelARN      fwtypes.ARN                                         `tfsdk:"model_arn"`
+	ParsingPrompt fwtypes.ListNestedObjectValueOf[parsingPromptModel] `tfsdk:"parsing_prompt"`
+}
+
+type parsingPromptModel struct {
+	ParsingPromptText types.String `tfsdk:"parsing_prompt_text"`
+}
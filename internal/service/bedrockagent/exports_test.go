@@ -10,6 +10,9 @@ var (
 	ResourceAgentAlias                    = newAgentAliasResource
 	ResourceAgentKnowledgeBaseAssociation = newAgentKnowledgeBaseAssociationResource
 	ResourceDataSource                    = newDataSourceResource
+	ResourceFlow                          = newFlowResource
+	ResourceFlowAlias                     = newFlowAliasResource
+	ResourceFlowVersion                   = newFlowVersionResource
 	ResourceKnowledgeBase                 = newKnowledgeBaseResource
 
 	FindAgentByID                                  = findAgentByID
@@ -17,5 +20,8 @@ var (
 	FindAgentAliasByTwoPartKey                     = findAgentAliasByTwoPartKey
 	FindAgentKnowledgeBaseAssociationByThreePartID = findAgentKnowledgeBaseAssociationByThreePartKey
 	FindDataSourceByTwoPartKey                     = findDataSourceByTwoPartKey
+	FindFlowAliasByTwoPartKey                      = findFlowAliasByTwoPartKey
+	FindFlowByID                                   = findFlowByID
+	FindFlowVersionByTwoPartKey                    = findFlowVersionByTwoPartKey
 	FindKnowledgeBaseByID                          = findKnowledgeBaseByID
 )
@@ -0,0 +1,978 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Validators: []validator.String{
+					fwvalidators.KMSKeyARN(),
+				},
+			},
+			"definition": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"execution_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				Validators: []validator.String{
+					fwvalidators.IAMRoleARN(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			"prepare_flow": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateFlowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	definition, err := expandFlowDefinition(data.Definition)
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+		return
+	}
+	input.Definition = definition
+	input.Tags = getTagsIn(ctx)
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateFlow(ctx, input)
+	}, errCodeValidationException, "cannot assume role")
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, outputRaw.(*bedrockagent.CreateFlowOutput).Id)
+
+	if data.PrepareFlow.ValueBool() && definition != nil {
+		if err := prepareFlow(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+			return
+		}
+	}
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		input := &bedrockagent.UpdateFlowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		definition, err := expandFlowDefinition(new.Definition)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+		input.Definition = definition
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateFlow(ctx, input)
+		}, errCodeValidationException, "cannot assume role")
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if new.PrepareFlow.ValueBool() && definition != nil {
+			if err := prepareFlow(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		output, err := findFlowByID(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.Status = old.Status
+		new.UpdatedAt = old.UpdatedAt
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlow(ctx, &bedrockagent.DeleteFlowInput{
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_flow to default value on import
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_flow"), true)...)
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) error {
+	input := &bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	_, err := conn.PrepareFlow(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("preparing Bedrock Agent Flow (%s): %w", id, err)
+	}
+
+	if _, err := waitFlowPrepared(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for Bedrock Agent Flow (%s) prepare: %w", id, err)
+	}
+
+	return nil
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFlow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                            `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                       `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                             `tfsdk:"customer_encryption_key_arn"`
+	Definition               jsontypes.Normalized                    `tfsdk:"definition"`
+	Description              types.String                            `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                             `tfsdk:"execution_role_arn"`
+	ID                       types.String                            `tfsdk:"id"`
+	Name                     types.String                            `tfsdk:"name"`
+	PrepareFlow              types.Bool                              `tfsdk:"prepare_flow"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus] `tfsdk:"status"`
+	Tags                     types.Map                               `tfsdk:"tags"`
+	TagsAll                  types.Map                               `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                          `tfsdk:"timeouts"`
+	UpdatedAt                timetypes.RFC3339                       `tfsdk:"updated_at"`
+	Version                  types.String                            `tfsdk:"version"`
+}
+
+func (m *flowResourceModel) flatten(ctx context.Context, output *bedrockagent.GetFlowOutput) (diags diag.Diagnostics) {
+	definition := m.Definition
+
+	diags.Append(fwflex.Flatten(ctx, output, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	v, err := flattenFlowDefinition(output.Definition, definition)
+	if err != nil {
+		diags.AddError("flattening Bedrock Agent Flow definition", err.Error())
+
+		return diags
+	}
+	m.Definition = v
+
+	return diags
+}
+
+// flowDefinitionJSON and the types below mirror the JSON representation of the
+// Bedrock Agents FlowDefinition structure, whose node and connection configurations
+// are modelled as Smithy unions that encoding/json cannot handle directly.
+type flowDefinitionJSON struct {
+	Connections []flowConnectionJSON `json:"connections,omitempty"`
+	Nodes       []flowNodeJSON       `json:"nodes,omitempty"`
+}
+
+type flowConnectionJSON struct {
+	Configuration *flowConnectionConfigurationJSON `json:"configuration,omitempty"`
+	Name          *string                          `json:"name,omitempty"`
+	Source        *string                          `json:"source,omitempty"`
+	Target        *string                          `json:"target,omitempty"`
+	Type          awstypes.FlowConnectionType      `json:"type,omitempty"`
+}
+
+type flowConnectionConfigurationJSON struct {
+	Conditional *flowConditionalConnectionConfigurationJSON `json:"conditional,omitempty"`
+	Data        *flowDataConnectionConfigurationJSON        `json:"data,omitempty"`
+}
+
+type flowConditionalConnectionConfigurationJSON struct {
+	Condition *string `json:"condition,omitempty"`
+}
+
+type flowDataConnectionConfigurationJSON struct {
+	SourceOutput *string `json:"sourceOutput,omitempty"`
+	TargetInput  *string `json:"targetInput,omitempty"`
+}
+
+type flowNodeJSON struct {
+	Configuration *flowNodeConfigurationJSON `json:"configuration,omitempty"`
+	Inputs        []flowNodeInputJSON        `json:"inputs,omitempty"`
+	Name          *string                    `json:"name,omitempty"`
+	Outputs       []flowNodeOutputJSON       `json:"outputs,omitempty"`
+	Type          awstypes.FlowNodeType      `json:"type,omitempty"`
+}
+
+type flowNodeInputJSON struct {
+	Expression *string                     `json:"expression,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Type       awstypes.FlowNodeIODataType `json:"type,omitempty"`
+}
+
+type flowNodeOutputJSON struct {
+	Name *string                     `json:"name,omitempty"`
+	Type awstypes.FlowNodeIODataType `json:"type,omitempty"`
+}
+
+type flowNodeConfigurationJSON struct {
+	Agent          *flowAgentNodeConfigurationJSON          `json:"agent,omitempty"`
+	Collector      *struct{}                                `json:"collector,omitempty"`
+	Condition      *flowConditionNodeConfigurationJSON      `json:"condition,omitempty"`
+	Input          *struct{}                                `json:"input,omitempty"`
+	Iterator       *struct{}                                `json:"iterator,omitempty"`
+	KnowledgeBase  *flowKnowledgeBaseNodeConfigurationJSON  `json:"knowledgeBase,omitempty"`
+	LambdaFunction *flowLambdaFunctionNodeConfigurationJSON `json:"lambdaFunction,omitempty"`
+	Lex            *flowLexNodeConfigurationJSON            `json:"lex,omitempty"`
+	Output         *struct{}                                `json:"output,omitempty"`
+	Prompt         *flowPromptNodeConfigurationJSON         `json:"prompt,omitempty"`
+	Retrieval      *flowS3NodeConfigurationJSON             `json:"retrieval,omitempty"`
+	Storage        *flowS3NodeConfigurationJSON             `json:"storage,omitempty"`
+}
+
+type flowAgentNodeConfigurationJSON struct {
+	AgentAliasARN *string `json:"agentAliasArn,omitempty"`
+}
+
+type flowConditionNodeConfigurationJSON struct {
+	Conditions []flowConditionJSON `json:"conditions,omitempty"`
+}
+
+type flowConditionJSON struct {
+	Expression *string `json:"expression,omitempty"`
+	Name       *string `json:"name,omitempty"`
+}
+
+type flowKnowledgeBaseNodeConfigurationJSON struct {
+	KnowledgeBaseID *string `json:"knowledgeBaseId,omitempty"`
+	ModelID         *string `json:"modelId,omitempty"`
+}
+
+type flowLambdaFunctionNodeConfigurationJSON struct {
+	LambdaARN *string `json:"lambdaArn,omitempty"`
+}
+
+type flowLexNodeConfigurationJSON struct {
+	BotAliasARN *string `json:"botAliasArn,omitempty"`
+	LocaleID    *string `json:"localeId,omitempty"`
+}
+
+type flowPromptNodeConfigurationJSON struct {
+	SourceConfiguration *flowPromptNodeSourceConfigurationJSON `json:"sourceConfiguration,omitempty"`
+}
+
+type flowPromptNodeSourceConfigurationJSON struct {
+	Inline   *flowPromptNodeInlineConfigurationJSON   `json:"inline,omitempty"`
+	Resource *flowPromptNodeResourceConfigurationJSON `json:"resource,omitempty"`
+}
+
+type flowPromptNodeInlineConfigurationJSON struct {
+	InferenceConfiguration *flowPromptInferenceConfigurationJSON `json:"inferenceConfiguration,omitempty"`
+	ModelID                *string                               `json:"modelId,omitempty"`
+	TemplateConfiguration  *flowPromptTemplateConfigurationJSON  `json:"templateConfiguration,omitempty"`
+	TemplateType           awstypes.PromptTemplateType           `json:"templateType,omitempty"`
+}
+
+type flowPromptInferenceConfigurationJSON struct {
+	Text *flowPromptModelInferenceConfigurationJSON `json:"text,omitempty"`
+}
+
+type flowPromptModelInferenceConfigurationJSON struct {
+	MaxTokens     *int32   `json:"maxTokens,omitempty"`
+	StopSequences []string `json:"stopSequences,omitempty"`
+	Temperature   *float32 `json:"temperature,omitempty"`
+	TopK          *int32   `json:"topK,omitempty"`
+	TopP          *float32 `json:"topP,omitempty"`
+}
+
+type flowPromptTemplateConfigurationJSON struct {
+	Text *flowTextPromptTemplateConfigurationJSON `json:"text,omitempty"`
+}
+
+type flowTextPromptTemplateConfigurationJSON struct {
+	InputVariables []flowPromptInputVariableJSON `json:"inputVariables,omitempty"`
+	Text           *string                       `json:"text,omitempty"`
+}
+
+type flowPromptInputVariableJSON struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type flowPromptNodeResourceConfigurationJSON struct {
+	PromptARN *string `json:"promptArn,omitempty"`
+}
+
+// flowS3NodeConfigurationJSON mirrors both RetrievalFlowNodeConfiguration and
+// StorageFlowNodeConfiguration, which have the same shape.
+type flowS3NodeConfigurationJSON struct {
+	ServiceConfiguration *flowS3NodeServiceConfigurationJSON `json:"serviceConfiguration,omitempty"`
+}
+
+type flowS3NodeServiceConfigurationJSON struct {
+	S3 *flowS3NodeS3ConfigurationJSON `json:"s3,omitempty"`
+}
+
+type flowS3NodeS3ConfigurationJSON struct {
+	BucketName *string `json:"bucketName,omitempty"`
+}
+
+func expandFlowDefinition(v jsontypes.Normalized) (*awstypes.FlowDefinition, error) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	tfObject, err := decodeFlowDefinition(v.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("decoding flow definition: %w", err)
+	}
+
+	apiObject := &awstypes.FlowDefinition{}
+
+	for _, tfConnection := range tfObject.Connections {
+		connection := awstypes.FlowConnection{
+			Name:   tfConnection.Name,
+			Source: tfConnection.Source,
+			Target: tfConnection.Target,
+			Type:   tfConnection.Type,
+		}
+
+		if c := tfConnection.Configuration; c != nil {
+			switch {
+			case c.Conditional != nil:
+				connection.Configuration = &awstypes.FlowConnectionConfigurationMemberConditional{Value: awstypes.FlowConditionalConnectionConfiguration{
+					Condition: c.Conditional.Condition,
+				}}
+			case c.Data != nil:
+				connection.Configuration = &awstypes.FlowConnectionConfigurationMemberData{Value: awstypes.FlowDataConnectionConfiguration{
+					SourceOutput: c.Data.SourceOutput,
+					TargetInput:  c.Data.TargetInput,
+				}}
+			}
+		}
+
+		apiObject.Connections = append(apiObject.Connections, connection)
+	}
+
+	for _, tfNode := range tfObject.Nodes {
+		node := awstypes.FlowNode{
+			Name: tfNode.Name,
+			Type: tfNode.Type,
+		}
+
+		for _, tfInput := range tfNode.Inputs {
+			node.Inputs = append(node.Inputs, awstypes.FlowNodeInput{
+				Expression: tfInput.Expression,
+				Name:       tfInput.Name,
+				Type:       tfInput.Type,
+			})
+		}
+
+		for _, tfOutput := range tfNode.Outputs {
+			node.Outputs = append(node.Outputs, awstypes.FlowNodeOutput{
+				Name: tfOutput.Name,
+				Type: tfOutput.Type,
+			})
+		}
+
+		if c := tfNode.Configuration; c != nil {
+			configuration, err := expandFlowNodeConfiguration(c)
+			if err != nil {
+				return nil, fmt.Errorf("node (%s): %w", aws.ToString(tfNode.Name), err)
+			}
+			node.Configuration = configuration
+		}
+
+		apiObject.Nodes = append(apiObject.Nodes, node)
+	}
+
+	return apiObject, nil
+}
+
+func expandFlowNodeConfiguration(tfObject *flowNodeConfigurationJSON) (awstypes.FlowNodeConfiguration, error) {
+	switch {
+	case tfObject.Agent != nil:
+		return &awstypes.FlowNodeConfigurationMemberAgent{Value: awstypes.AgentFlowNodeConfiguration{
+			AgentAliasArn: tfObject.Agent.AgentAliasARN,
+		}}, nil
+	case tfObject.Collector != nil:
+		return &awstypes.FlowNodeConfigurationMemberCollector{Value: awstypes.CollectorFlowNodeConfiguration{}}, nil
+	case tfObject.Condition != nil:
+		apiObject := awstypes.ConditionFlowNodeConfiguration{}
+		for _, v := range tfObject.Condition.Conditions {
+			apiObject.Conditions = append(apiObject.Conditions, awstypes.FlowCondition{
+				Expression: v.Expression,
+				Name:       v.Name,
+			})
+		}
+		return &awstypes.FlowNodeConfigurationMemberCondition{Value: apiObject}, nil
+	case tfObject.Input != nil:
+		return &awstypes.FlowNodeConfigurationMemberInput{Value: awstypes.InputFlowNodeConfiguration{}}, nil
+	case tfObject.Iterator != nil:
+		return &awstypes.FlowNodeConfigurationMemberIterator{Value: awstypes.IteratorFlowNodeConfiguration{}}, nil
+	case tfObject.KnowledgeBase != nil:
+		return &awstypes.FlowNodeConfigurationMemberKnowledgeBase{Value: awstypes.KnowledgeBaseFlowNodeConfiguration{
+			KnowledgeBaseId: tfObject.KnowledgeBase.KnowledgeBaseID,
+			ModelId:         tfObject.KnowledgeBase.ModelID,
+		}}, nil
+	case tfObject.LambdaFunction != nil:
+		return &awstypes.FlowNodeConfigurationMemberLambdaFunction{Value: awstypes.LambdaFunctionFlowNodeConfiguration{
+			LambdaArn: tfObject.LambdaFunction.LambdaARN,
+		}}, nil
+	case tfObject.Lex != nil:
+		return &awstypes.FlowNodeConfigurationMemberLex{Value: awstypes.LexFlowNodeConfiguration{
+			BotAliasArn: tfObject.Lex.BotAliasARN,
+			LocaleId:    tfObject.Lex.LocaleID,
+		}}, nil
+	case tfObject.Output != nil:
+		return &awstypes.FlowNodeConfigurationMemberOutput{Value: awstypes.OutputFlowNodeConfiguration{}}, nil
+	case tfObject.Prompt != nil:
+		apiObject := awstypes.PromptFlowNodeConfiguration{}
+		if s := tfObject.Prompt.SourceConfiguration; s != nil {
+			switch {
+			case s.Inline != nil:
+				inline := awstypes.PromptFlowNodeInlineConfiguration{
+					ModelId:      s.Inline.ModelID,
+					TemplateType: s.Inline.TemplateType,
+				}
+				if v := s.Inline.InferenceConfiguration; v != nil && v.Text != nil {
+					inline.InferenceConfiguration = &awstypes.PromptInferenceConfigurationMemberText{Value: awstypes.PromptModelInferenceConfiguration{
+						MaxTokens:     v.Text.MaxTokens,
+						StopSequences: v.Text.StopSequences,
+						Temperature:   v.Text.Temperature,
+						TopK:          v.Text.TopK,
+						TopP:          v.Text.TopP,
+					}}
+				}
+				if v := s.Inline.TemplateConfiguration; v != nil && v.Text != nil {
+					text := awstypes.TextPromptTemplateConfiguration{
+						Text: v.Text.Text,
+					}
+					for _, v := range v.Text.InputVariables {
+						text.InputVariables = append(text.InputVariables, awstypes.PromptInputVariable{Name: v.Name})
+					}
+					inline.TemplateConfiguration = &awstypes.PromptTemplateConfigurationMemberText{Value: text}
+				}
+				apiObject.SourceConfiguration = &awstypes.PromptFlowNodeSourceConfigurationMemberInline{Value: inline}
+			case s.Resource != nil:
+				apiObject.SourceConfiguration = &awstypes.PromptFlowNodeSourceConfigurationMemberResource{Value: awstypes.PromptFlowNodeResourceConfiguration{
+					PromptArn: s.Resource.PromptARN,
+				}}
+			}
+		}
+		return &awstypes.FlowNodeConfigurationMemberPrompt{Value: apiObject}, nil
+	case tfObject.Retrieval != nil:
+		apiObject := awstypes.RetrievalFlowNodeConfiguration{}
+		if s := tfObject.Retrieval.ServiceConfiguration; s != nil && s.S3 != nil {
+			apiObject.ServiceConfiguration = &awstypes.RetrievalFlowNodeServiceConfigurationMemberS3{Value: awstypes.RetrievalFlowNodeS3Configuration{
+				BucketName: s.S3.BucketName,
+			}}
+		}
+		return &awstypes.FlowNodeConfigurationMemberRetrieval{Value: apiObject}, nil
+	case tfObject.Storage != nil:
+		apiObject := awstypes.StorageFlowNodeConfiguration{}
+		if s := tfObject.Storage.ServiceConfiguration; s != nil && s.S3 != nil {
+			apiObject.ServiceConfiguration = &awstypes.StorageFlowNodeServiceConfigurationMemberS3{Value: awstypes.StorageFlowNodeS3Configuration{
+				BucketName: s.S3.BucketName,
+			}}
+		}
+		return &awstypes.FlowNodeConfigurationMemberStorage{Value: apiObject}, nil
+	}
+
+	return nil, errors.New("unsupported or empty node configuration")
+}
+
+// flattenFlowDefinition returns the JSON representation of the specified flow definition.
+// The configured value is retained if it is semantically equivalent to the API value.
+func flattenFlowDefinition(apiObject *awstypes.FlowDefinition, configured jsontypes.Normalized) (jsontypes.Normalized, error) {
+	if apiObject == nil {
+		return jsontypes.NewNormalizedNull(), nil
+	}
+
+	tfObject := flowDefinitionJSON{}
+
+	for _, connection := range apiObject.Connections {
+		tfConnection := flowConnectionJSON{
+			Name:   connection.Name,
+			Source: connection.Source,
+			Target: connection.Target,
+			Type:   connection.Type,
+		}
+
+		switch v := connection.Configuration.(type) {
+		case *awstypes.FlowConnectionConfigurationMemberConditional:
+			tfConnection.Configuration = &flowConnectionConfigurationJSON{Conditional: &flowConditionalConnectionConfigurationJSON{
+				Condition: v.Value.Condition,
+			}}
+		case *awstypes.FlowConnectionConfigurationMemberData:
+			tfConnection.Configuration = &flowConnectionConfigurationJSON{Data: &flowDataConnectionConfigurationJSON{
+				SourceOutput: v.Value.SourceOutput,
+				TargetInput:  v.Value.TargetInput,
+			}}
+		}
+
+		tfObject.Connections = append(tfObject.Connections, tfConnection)
+	}
+
+	for _, node := range apiObject.Nodes {
+		configuration, err := flattenFlowNodeConfiguration(node.Configuration)
+		if err != nil {
+			return jsontypes.NewNormalizedNull(), fmt.Errorf("node (%s): %w", aws.ToString(node.Name), err)
+		}
+
+		tfNode := flowNodeJSON{
+			Configuration: configuration,
+			Name:          node.Name,
+			Type:          node.Type,
+		}
+
+		for _, v := range node.Inputs {
+			tfNode.Inputs = append(tfNode.Inputs, flowNodeInputJSON{
+				Expression: v.Expression,
+				Name:       v.Name,
+				Type:       v.Type,
+			})
+		}
+
+		for _, v := range node.Outputs {
+			tfNode.Outputs = append(tfNode.Outputs, flowNodeOutputJSON{
+				Name: v.Name,
+				Type: v.Type,
+			})
+		}
+
+		tfObject.Nodes = append(tfObject.Nodes, tfNode)
+	}
+
+	b, err := json.Marshal(tfObject)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+
+	if !configured.IsNull() && !configured.IsUnknown() {
+		if v, err := canonicalizeFlowDefinition(configured.ValueString()); err == nil && v == string(b) {
+			return configured, nil
+		}
+	}
+
+	return jsontypes.NewNormalizedValue(string(b)), nil
+}
+
+func flattenFlowNodeConfiguration(apiObject awstypes.FlowNodeConfiguration) (*flowNodeConfigurationJSON, error) {
+	switch v := apiObject.(type) {
+	case nil:
+		return nil, nil
+	case *awstypes.FlowNodeConfigurationMemberAgent:
+		return &flowNodeConfigurationJSON{Agent: &flowAgentNodeConfigurationJSON{
+			AgentAliasARN: v.Value.AgentAliasArn,
+		}}, nil
+	case *awstypes.FlowNodeConfigurationMemberCollector:
+		return &flowNodeConfigurationJSON{Collector: &struct{}{}}, nil
+	case *awstypes.FlowNodeConfigurationMemberCondition:
+		tfObject := &flowConditionNodeConfigurationJSON{}
+		for _, v := range v.Value.Conditions {
+			tfObject.Conditions = append(tfObject.Conditions, flowConditionJSON{
+				Expression: v.Expression,
+				Name:       v.Name,
+			})
+		}
+		return &flowNodeConfigurationJSON{Condition: tfObject}, nil
+	case *awstypes.FlowNodeConfigurationMemberInput:
+		return &flowNodeConfigurationJSON{Input: &struct{}{}}, nil
+	case *awstypes.FlowNodeConfigurationMemberIterator:
+		return &flowNodeConfigurationJSON{Iterator: &struct{}{}}, nil
+	case *awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		return &flowNodeConfigurationJSON{KnowledgeBase: &flowKnowledgeBaseNodeConfigurationJSON{
+			KnowledgeBaseID: v.Value.KnowledgeBaseId,
+			ModelID:         v.Value.ModelId,
+		}}, nil
+	case *awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		return &flowNodeConfigurationJSON{LambdaFunction: &flowLambdaFunctionNodeConfigurationJSON{
+			LambdaARN: v.Value.LambdaArn,
+		}}, nil
+	case *awstypes.FlowNodeConfigurationMemberLex:
+		return &flowNodeConfigurationJSON{Lex: &flowLexNodeConfigurationJSON{
+			BotAliasARN: v.Value.BotAliasArn,
+			LocaleID:    v.Value.LocaleId,
+		}}, nil
+	case *awstypes.FlowNodeConfigurationMemberOutput:
+		return &flowNodeConfigurationJSON{Output: &struct{}{}}, nil
+	case *awstypes.FlowNodeConfigurationMemberPrompt:
+		tfObject := &flowPromptNodeConfigurationJSON{}
+		switch s := v.Value.SourceConfiguration.(type) {
+		case *awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+			inline := &flowPromptNodeInlineConfigurationJSON{
+				ModelID:      s.Value.ModelId,
+				TemplateType: s.Value.TemplateType,
+			}
+			if v, ok := s.Value.InferenceConfiguration.(*awstypes.PromptInferenceConfigurationMemberText); ok {
+				inline.InferenceConfiguration = &flowPromptInferenceConfigurationJSON{Text: &flowPromptModelInferenceConfigurationJSON{
+					MaxTokens:     v.Value.MaxTokens,
+					StopSequences: v.Value.StopSequences,
+					Temperature:   v.Value.Temperature,
+					TopK:          v.Value.TopK,
+					TopP:          v.Value.TopP,
+				}}
+			}
+			if v, ok := s.Value.TemplateConfiguration.(*awstypes.PromptTemplateConfigurationMemberText); ok {
+				text := &flowTextPromptTemplateConfigurationJSON{
+					Text: v.Value.Text,
+				}
+				for _, v := range v.Value.InputVariables {
+					text.InputVariables = append(text.InputVariables, flowPromptInputVariableJSON{Name: v.Name})
+				}
+				inline.TemplateConfiguration = &flowPromptTemplateConfigurationJSON{Text: text}
+			}
+			tfObject.SourceConfiguration = &flowPromptNodeSourceConfigurationJSON{Inline: inline}
+		case *awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+			tfObject.SourceConfiguration = &flowPromptNodeSourceConfigurationJSON{Resource: &flowPromptNodeResourceConfigurationJSON{
+				PromptARN: s.Value.PromptArn,
+			}}
+		}
+		return &flowNodeConfigurationJSON{Prompt: tfObject}, nil
+	case *awstypes.FlowNodeConfigurationMemberRetrieval:
+		tfObject := &flowS3NodeConfigurationJSON{}
+		if s, ok := v.Value.ServiceConfiguration.(*awstypes.RetrievalFlowNodeServiceConfigurationMemberS3); ok {
+			tfObject.ServiceConfiguration = &flowS3NodeServiceConfigurationJSON{S3: &flowS3NodeS3ConfigurationJSON{
+				BucketName: s.Value.BucketName,
+			}}
+		} else if v.Value.ServiceConfiguration != nil {
+			return nil, fmt.Errorf("unsupported retrieval service configuration (%T)", v.Value.ServiceConfiguration)
+		}
+		return &flowNodeConfigurationJSON{Retrieval: tfObject}, nil
+	case *awstypes.FlowNodeConfigurationMemberStorage:
+		tfObject := &flowS3NodeConfigurationJSON{}
+		if s, ok := v.Value.ServiceConfiguration.(*awstypes.StorageFlowNodeServiceConfigurationMemberS3); ok {
+			tfObject.ServiceConfiguration = &flowS3NodeServiceConfigurationJSON{S3: &flowS3NodeS3ConfigurationJSON{
+				BucketName: s.Value.BucketName,
+			}}
+		} else if v.Value.ServiceConfiguration != nil {
+			return nil, fmt.Errorf("unsupported storage service configuration (%T)", v.Value.ServiceConfiguration)
+		}
+		return &flowNodeConfigurationJSON{Storage: tfObject}, nil
+	}
+
+	// The configuration is not modelled by the provider, e.g. a node type added to the service after the
+	// pinned SDK was released, and is returned as *awstypes.UnknownUnionMember.
+	// Fail rather than silently drop it from state.
+	return nil, fmt.Errorf("unsupported node configuration (%T)", apiObject)
+}
+
+// canonicalizeFlowDefinition round-trips a flow definition document through its
+// JSON mirror types, dropping empty values.
+func canonicalizeFlowDefinition(s string) (string, error) {
+	tfObject, err := decodeFlowDefinition(s)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(tfObject)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// decodeFlowDefinition decodes a flow definition document into its JSON mirror types.
+// Unknown keys are rejected so that misspelled or unsupported settings are not silently dropped.
+func decodeFlowDefinition(s string) (flowDefinitionJSON, error) {
+	var tfObject flowDefinitionJSON
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&tfObject); err != nil {
+		return flowDefinitionJSON{}, err
+	}
+
+	return tfObject, nil
+}
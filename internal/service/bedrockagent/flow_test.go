@@ -0,0 +1,545 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockAgentFlow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow.test"
+	var v bedrockagent.GetFlowOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckNoResourceAttr(resourceName, "definition"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test_flow", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "prepare_flow", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.FlowStatusNotPrepared)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "DRAFT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentFlow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow.test"
+	var v bedrockagent.GetFlowOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceFlow, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentFlow_definition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow.test"
+	var v bedrockagent.GetFlowOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_definition(rName, "Summarize the following text: {{text}}", 0.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.FlowStatusPrepared)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+			{
+				Config: testAccFlowConfig_definition(rName, "Translate the following text to French: {{text}}", 0.2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.FlowStatusPrepared)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentFlow_definitionS3Nodes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow.test"
+	var v bedrockagent.GetFlowOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_definitionS3Nodes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.FlowStatusPrepared)),
+				),
+			},
+			{
+				Config: testAccFlowConfig_definitionS3Nodes(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentFlow_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_flow.test"
+	var v bedrockagent.GetFlowOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccFlowConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_flow" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindFlowByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Flow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowExists(ctx context.Context, n string, v *bedrockagent.GetFlowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindFlowByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFlowConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test_flow" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.test_flow_trust.json
+}
+
+data "aws_iam_policy_document" "test_flow_trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      identifiers = ["bedrock.amazonaws.com"]
+      type        = "Service"
+    }
+    condition {
+      test     = "StringEquals"
+      values   = [data.aws_caller_identity.current_flow.account_id]
+      variable = "aws:SourceAccount"
+    }
+    condition {
+      test     = "ArnLike"
+      values   = ["arn:${data.aws_partition.current_flow.partition}:bedrock:${data.aws_region.current_flow.name}:${data.aws_caller_identity.current_flow.account_id}:flow/*"]
+      variable = "AWS:SourceArn"
+    }
+  }
+}
+
+data "aws_iam_policy_document" "test_flow_permissions" {
+  statement {
+    actions = ["bedrock:InvokeModel"]
+    resources = [
+      "arn:${data.aws_partition.current_flow.partition}:bedrock:${data.aws_region.current_flow.name}::foundation-model/anthropic.claude-v2",
+    ]
+  }
+}
+
+resource "aws_iam_role_policy" "test_flow" {
+  role   = aws_iam_role.test_flow.id
+  policy = data.aws_iam_policy_document.test_flow_permissions.json
+}
+
+data "aws_caller_identity" "current_flow" {}
+
+data "aws_region" "current_flow" {}
+
+data "aws_partition" "current_flow" {}
+`, rName)
+}
+
+func testAccFlowConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_flow" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test_flow.arn
+}
+`, rName))
+}
+
+func testAccFlowConfig_definition(rName, promptText string, temperature float64) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_flow" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test_flow.arn
+
+  definition = jsonencode({
+    nodes = [
+      {
+        name          = "FlowInput"
+        type          = "Input"
+        configuration = { input = {} }
+        outputs = [
+          { name = "document", type = "String" },
+        ]
+      },
+      {
+        name = "Prompt"
+        type = "Prompt"
+        configuration = {
+          prompt = {
+            sourceConfiguration = {
+              inline = {
+                modelId      = "anthropic.claude-v2"
+                templateType = "TEXT"
+                templateConfiguration = {
+                  text = {
+                    text           = %[2]q
+                    inputVariables = [{ name = "text" }]
+                  }
+                }
+                inferenceConfiguration = {
+                  text = {
+                    maxTokens   = 512
+                    temperature = %[3]f
+                  }
+                }
+              }
+            }
+          }
+        }
+        inputs = [
+          { name = "text", type = "String", expression = "$.data" },
+        ]
+        outputs = [
+          { name = "modelCompletion", type = "String" },
+        ]
+      },
+      {
+        name          = "FlowOutput"
+        type          = "Output"
+        configuration = { output = {} }
+        inputs = [
+          { name = "document", type = "String", expression = "$.data" },
+        ]
+      },
+    ]
+    connections = [
+      {
+        name   = "FlowInputToPrompt"
+        source = "FlowInput"
+        target = "Prompt"
+        type   = "Data"
+        configuration = {
+          data = { sourceOutput = "document", targetInput = "text" }
+        }
+      },
+      {
+        name   = "PromptToFlowOutput"
+        source = "Prompt"
+        target = "FlowOutput"
+        type   = "Data"
+        configuration = {
+          data = { sourceOutput = "modelCompletion", targetInput = "document" }
+        }
+      },
+    ]
+  })
+
+  depends_on = [aws_iam_role_policy.test_flow]
+}
+`, rName, promptText, temperature))
+}
+
+func testAccFlowConfig_definitionS3Nodes(rName string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_bedrockagent_flow" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test_flow.arn
+
+  definition = jsonencode({
+    nodes = [
+      {
+        name          = "FlowInput"
+        type          = "Input"
+        configuration = { input = {} }
+        outputs = [
+          { name = "document", type = "String" },
+        ]
+      },
+      {
+        name = "Storage"
+        type = "Storage"
+        configuration = {
+          storage = {
+            serviceConfiguration = {
+              s3 = { bucketName = aws_s3_bucket.test.bucket }
+            }
+          }
+        }
+        inputs = [
+          { name = "content", type = "String", expression = "$.data" },
+          { name = "objectKey", type = "String", expression = "$.data" },
+        ]
+        outputs = [
+          { name = "s3Uri", type = "String" },
+        ]
+      },
+      {
+        name = "Retrieval"
+        type = "Retrieval"
+        configuration = {
+          retrieval = {
+            serviceConfiguration = {
+              s3 = { bucketName = aws_s3_bucket.test.bucket }
+            }
+          }
+        }
+        inputs = [
+          { name = "objectKey", type = "String", expression = "$.data" },
+        ]
+        outputs = [
+          { name = "s3Content", type = "String" },
+        ]
+      },
+      {
+        name          = "StorageOutput"
+        type          = "Output"
+        configuration = { output = {} }
+        inputs = [
+          { name = "document", type = "String", expression = "$.data" },
+        ]
+      },
+      {
+        name          = "RetrievalOutput"
+        type          = "Output"
+        configuration = { output = {} }
+        inputs = [
+          { name = "document", type = "String", expression = "$.data" },
+        ]
+      },
+    ]
+    connections = [
+      {
+        name   = "FlowInputToStorageContent"
+        source = "FlowInput"
+        target = "Storage"
+        type   = "Data"
+        configuration = {
+          data = { sourceOutput = "document", targetInput = "content" }
+        }
+      },
+      {
+        name   = "FlowInputToStorageObjectKey"
+        source = "FlowInput"
+        target = "Storage"
+        type   = "Data"
+        configuration = {
+          data = { sourceOutput = "document", targetInput = "objectKey" }
+        }
+      },
+      {
+        name   = "FlowInputToRetrieval"
+        source = "FlowInput"
+        target = "Retrieval"
+        type   = "Data"
+        configuration = {
+          data = { sourceOutput = "document", targetInput = "objectKey" }
+        }
+      },
+      {
+        name   = "StorageToStorageOutput"
+        source = "Storage"
+        target = "StorageOutput"
+        type   = "Data"
+        configuration = {
+          data = { sourceOutput = "s3Uri", targetInput = "document" }
+        }
+      },
+      {
+        name   = "RetrievalToRetrievalOutput"
+        source = "Retrieval"
+        target = "RetrievalOutput"
+        type   = "Data"
+        configuration = {
+          data = { sourceOutput = "s3Content", targetInput = "document" }
+        }
+      },
+    ]
+  })
+
+  depends_on = [aws_iam_role_policy.test_flow]
+}
+`, rName))
+}
+
+func testAccFlowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_flow" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test_flow.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFlowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrockagent_flow" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test_flow.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
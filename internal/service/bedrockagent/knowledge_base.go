@@ -338,9 +338,23 @@ func (r *knowledgeBaseResource) Create(ctx context.Context, request resource.Cre
 	input.ClientToken = aws.String(id.UniqueId())
 	input.Tags = getTagsIn(ctx)
 
-	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
-		return conn.CreateKnowledgeBase(ctx, input)
-	}, errCodeValidationException, "cannot assume role")
+	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateKnowledgeBase(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "cannot assume role") {
+				return true, err
+			}
+
+			// A vector index created in the same apply may not yet be visible to Bedrock.
+			if errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "no such index") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if err != nil {
 		response.Diagnostics.AddError("creating Bedrock Agent Knowledge Base", err.Error())
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Microsoft Teams Team")
+func newDataSourceMicrosoftTeamsTeam(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceMicrosoftTeamsTeam{}, nil
+}
+
+const (
+	DSNameMicrosoftTeamsTeam = "Microsoft Teams Team Data Source"
+)
+
+type dataSourceMicrosoftTeamsTeam struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceMicrosoftTeamsTeam) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_chatbot_microsoft_teams_team"
+}
+
+func (d *dataSourceMicrosoftTeamsTeam) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"team_name": schema.StringAttribute{
+				Required: true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceMicrosoftTeamsTeam) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ChatbotClient(ctx)
+
+	var data dataSourceMicrosoftTeamsTeamData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findMicrosoftTeamsTeamByName(ctx, conn, data.TeamName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Chatbot, create.ErrActionReading, DSNameMicrosoftTeamsTeam, data.TeamName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.TeamID = flex.StringToFramework(ctx, out.TeamId)
+	data.TeamName = flex.StringToFramework(ctx, out.TeamName)
+	data.TenantID = flex.StringToFramework(ctx, out.TenantId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findMicrosoftTeamsTeamByName(ctx context.Context, conn *chatbot.Client, team_name string) (*awstypes.ConfiguredTeam, error) {
+	input := &chatbot.ListMicrosoftTeamsConfiguredTeamsInput{
+		MaxResults: aws.Int32(10),
+	}
+
+	for {
+		output, err := conn.ListMicrosoftTeamsConfiguredTeams(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, team := range output.ConfiguredTeams {
+			if aws.ToString(team.TeamName) == team_name {
+				return &team, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	// If we are here, then we need to return an error that the data source was not found.
+	return nil, create.Error(names.Chatbot, "missing", DSNameMicrosoftTeamsTeam, team_name, nil)
+}
+
+type dataSourceMicrosoftTeamsTeamData struct {
+	TeamID   types.String `tfsdk:"team_id"`
+	TeamName types.String `tfsdk:"team_name"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotMicrosoftTeamsTeamDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// The Microsoft Teams team must be configured via the AWS Console. It cannot be configured via APIs or Terraform.
+	// Once it is configured, export the name of the team in the env variable for this test.
+	teamName := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_MICROSOFT_TEAMS_TEAM_NAME")
+	dataSourceName := "data.aws_chatbot_microsoft_teams_team.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMicrosoftTeamsTeamDataSourceConfig_basic(teamName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "team_name", teamName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "team_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tenant_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMicrosoftTeamsTeamDataSourceConfig_basic(teamName string) string {
+	return fmt.Sprintf(`
+data "aws_chatbot_microsoft_teams_team" "test" {
+  team_name = %[1]q
+}
+`, teamName)
+}
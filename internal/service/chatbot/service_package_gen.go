@@ -14,6 +14,10 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceMicrosoftTeamsTeam,
+			Name:    "Microsoft Teams Team",
+		},
 		{
 			Factory: newDataSourceSlackWorkspace,
 			Name:    "Slack Workspace",
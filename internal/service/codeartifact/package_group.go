@@ -0,0 +1,457 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codeartifact_package_group", name="Package Group")
+// @Tags(identifierAttribute="arn")
+func resourcePackageGroup() *schema.Resource {
+	originRestrictionSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allowed_repositories": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"effective_mode": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"inherited_from": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					names.AttrMode: {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Computed:         true,
+						ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionMode](),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageGroupCreate,
+		ReadWithoutTimeout:   resourcePackageGroupRead,
+		UpdateWithoutTimeout: resourcePackageGroupUpdate,
+		DeleteWithoutTimeout: resourcePackageGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_info": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"origin_restrictions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_upstream": originRestrictionSchema(),
+						"internal_upstream": originRestrictionSchema(),
+						"publish":           originRestrictionSchema(),
+					},
+				},
+			},
+			"parent_pattern": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	packageGroupResourceIDPartCount = 3
+)
+
+var (
+	// Maps origin_restrictions attribute names to the restriction types they configure.
+	packageGroupOriginRestrictionTypes = map[string]types.PackageGroupOriginRestrictionType{
+		"external_upstream": types.PackageGroupOriginRestrictionTypeExternalUpstream,
+		"internal_upstream": types.PackageGroupOriginRestrictionTypeInternalUpstream,
+		"publish":           types.PackageGroupOriginRestrictionTypePublish,
+	}
+)
+
+func resourcePackageGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	pattern := d.Get("pattern").(string)
+	input := &codeartifact.CreatePackageGroupInput{
+		Domain:       aws.String(d.Get(names.AttrDomain).(string)),
+		PackageGroup: aws.String(pattern),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_info"); ok {
+		input.ContactInfo = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_owner"); ok {
+		input.DomainOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePackageGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Group (%s): %s", pattern, err)
+	}
+
+	packageGroup := output.PackageGroup
+	id, err := flex.FlattenResourceId([]string{aws.ToString(packageGroup.DomainOwner), aws.ToString(packageGroup.DomainName), aws.ToString(packageGroup.Pattern)}, packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.GetOk("origin_restrictions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandUpdatePackageGroupOriginConfigurationInput(nil, v.([]interface{})[0].(map[string]interface{}))
+		input.Domain = packageGroup.DomainName
+		input.DomainOwner = packageGroup.DomainOwner
+		input.PackageGroup = packageGroup.Pattern
+
+		_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+	packageGroup, err := findPackageGroupByThreePartKey(ctx, conn, owner, domainName, pattern)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	allowedRepositories := make(map[types.PackageGroupOriginRestrictionType][]string)
+	for _, restrictionType := range packageGroupOriginRestrictionTypes {
+		repositoryNames, err := findAllowedRepositoriesForPackageGroup(ctx, conn, owner, domainName, pattern, restrictionType)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s) %s allowed repositories: %s", d.Id(), restrictionType, err)
+		}
+
+		allowedRepositories[restrictionType] = repositoryNames
+	}
+
+	d.Set(names.AttrARN, packageGroup.Arn)
+	d.Set("contact_info", packageGroup.ContactInfo)
+	if packageGroup.CreatedTime != nil {
+		d.Set(names.AttrCreatedTime, packageGroup.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, packageGroup.Description)
+	d.Set(names.AttrDomain, packageGroup.DomainName)
+	d.Set("domain_owner", packageGroup.DomainOwner)
+	if err := d.Set("origin_restrictions", flattenPackageGroupOriginConfiguration(packageGroup.OriginConfiguration, allowedRepositories)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting origin_restrictions: %s", err)
+	}
+	if packageGroup.Parent != nil {
+		d.Set("parent_pattern", packageGroup.Parent.Pattern)
+	} else {
+		d.Set("parent_pattern", nil)
+	}
+	d.Set("pattern", packageGroup.Pattern)
+
+	return diags
+}
+
+func resourcePackageGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	if d.HasChanges("contact_info", names.AttrDescription) {
+		input := &codeartifact.UpdatePackageGroupInput{
+			ContactInfo:  aws.String(d.Get("contact_info").(string)),
+			Description:  aws.String(d.Get(names.AttrDescription).(string)),
+			Domain:       aws.String(domainName),
+			DomainOwner:  aws.String(owner),
+			PackageGroup: aws.String(pattern),
+		}
+
+		_, err := conn.UpdatePackageGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("origin_restrictions") {
+		var tfMapOld, tfMapNew map[string]interface{}
+
+		o, n := d.GetChange("origin_restrictions")
+		if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+			tfMapOld = v[0].(map[string]interface{})
+		}
+		if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+			tfMapNew = v[0].(map[string]interface{})
+		}
+
+		input := expandUpdatePackageGroupOriginConfigurationInput(tfMapOld, tfMapNew)
+		input.Domain = aws.String(domainName)
+		input.DomainOwner = aws.String(owner)
+		input.PackageGroup = aws.String(pattern)
+
+		_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Group: %s", d.Id())
+	_, err = conn.DeletePackageGroup(ctx, &codeartifact.DeletePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPackageGroupByThreePartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string) (*types.PackageGroupDescription, error) {
+	input := &codeartifact.DescribePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+	}
+
+	output, err := conn.DescribePackageGroup(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PackageGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PackageGroup, nil
+}
+
+func findAllowedRepositoriesForPackageGroup(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string, restrictionType types.PackageGroupOriginRestrictionType) ([]string, error) {
+	input := &codeartifact.ListAllowedRepositoriesForGroupInput{
+		Domain:                aws.String(domainName),
+		DomainOwner:           aws.String(owner),
+		OriginRestrictionType: restrictionType,
+		PackageGroup:          aws.String(pattern),
+	}
+	var output []string
+
+	pages := codeartifact.NewListAllowedRepositoriesForGroupPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AllowedRepositories...)
+	}
+
+	return output, nil
+}
+
+// expandUpdatePackageGroupOriginConfigurationInput builds the restriction modes and allowed repository changes needed
+// to move from the old to the new origin_restrictions configuration.
+func expandUpdatePackageGroupOriginConfigurationInput(tfMapOld, tfMapNew map[string]interface{}) *codeartifact.UpdatePackageGroupOriginConfigurationInput {
+	input := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+		Restrictions: make(map[string]types.PackageGroupOriginRestrictionMode),
+	}
+
+	for tfAttributeName, restrictionType := range packageGroupOriginRestrictionTypes {
+		var tfMapRestrictionOld, tfMapRestrictionNew map[string]interface{}
+
+		if v, ok := tfMapOld[tfAttributeName].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMapRestrictionOld = v[0].(map[string]interface{})
+		}
+		if v, ok := tfMapNew[tfAttributeName].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMapRestrictionNew = v[0].(map[string]interface{})
+		}
+
+		if v, ok := tfMapRestrictionNew[names.AttrMode].(string); ok && v != "" {
+			input.Restrictions[string(restrictionType)] = types.PackageGroupOriginRestrictionMode(v)
+		}
+
+		oldSet, newSet := schema.NewSet(schema.HashString, nil), schema.NewSet(schema.HashString, nil)
+		if v, ok := tfMapRestrictionOld["allowed_repositories"].(*schema.Set); ok {
+			oldSet = v
+		}
+		if v, ok := tfMapRestrictionNew["allowed_repositories"].(*schema.Set); ok {
+			newSet = v
+		}
+
+		for _, v := range flex.ExpandStringValueSet(newSet.Difference(oldSet)) {
+			input.AddAllowedRepositories = append(input.AddAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v),
+			})
+		}
+		for _, v := range flex.ExpandStringValueSet(oldSet.Difference(newSet)) {
+			input.RemoveAllowedRepositories = append(input.RemoveAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v),
+			})
+		}
+	}
+
+	return input
+}
+
+func flattenPackageGroupOriginConfiguration(apiObject *types.PackageGroupOriginConfiguration, allowedRepositories map[types.PackageGroupOriginRestrictionType][]string) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for tfAttributeName, restrictionType := range packageGroupOriginRestrictionTypes {
+		restriction, ok := apiObject.Restrictions[string(restrictionType)]
+		if !ok {
+			continue
+		}
+
+		tfMapRestriction := map[string]interface{}{
+			"allowed_repositories": allowedRepositories[restrictionType],
+			"effective_mode":       restriction.EffectiveMode,
+			names.AttrMode:         restriction.Mode,
+		}
+
+		if v := restriction.InheritedFrom; v != nil {
+			tfMapRestriction["inherited_from"] = aws.ToString(v.Pattern)
+		}
+
+		tfMap[tfAttributeName] = []interface{}{tfMapRestriction}
+	}
+
+	return []interface{}{tfMap}
+}
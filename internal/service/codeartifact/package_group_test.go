@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeartifact "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPackageGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", ""),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomain, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_owner", "aws_codeartifact_domain.test", names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.publish.0.mode", "INHERIT"),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.publish.0.effective_mode", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.publish.0.inherited_from", "/*"),
+					resource.TestCheckResourceAttr(resourceName, "parent_pattern", "/*"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "/npm/*"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodeartifact.ResourcePackageGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_originRestrictions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_originRestrictions(rName, "BLOCK", "ALLOW_SPECIFIC_REPOSITORIES"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.external_upstream.0.mode", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.external_upstream.0.effective_mode", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.publish.0.mode", "ALLOW_SPECIFIC_REPOSITORIES"),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.publish.0.allowed_repositories.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origin_restrictions.0.publish.0.allowed_repositories.*", "aws_codeartifact_repository.test", "repository"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_originRestrictions(rName, "ALLOW", "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.external_upstream.0.mode", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "origin_restrictions.0.publish.0.mode", "ALLOW"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPackageGroupConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+		_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+		return err
+	}
+}
+
+func testAccCheckPackageGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeartifact_package_group" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+			_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeArtifact Package Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeartifact_domain" "test" {
+  domain = %[1]q
+}
+`, rName)
+}
+
+func testAccPackageGroupConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain      = aws_codeartifact_domain.test.domain
+  pattern     = "/npm/*"
+  description = %[1]q
+}
+`, description))
+}
+
+func testAccPackageGroupConfig_originRestrictions(rName, externalUpstreamMode, publishMode string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+
+  origin_restrictions {
+    external_upstream {
+      mode = %[2]q
+    }
+
+    publish {
+      mode                 = %[3]q
+      allowed_repositories = [aws_codeartifact_repository.test.repository]
+    }
+  }
+}
+`, rName, externalUpstreamMode, publishMode))
+}
+
+func testAccPackageGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccPackageGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
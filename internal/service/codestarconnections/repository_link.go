@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codestarconnections
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codestarconnections_repository_link", name="Repository Link")
+// @Tags(identifierAttribute="arn")
+func resourceRepositoryLink() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRepositoryLinkCreate,
+		ReadWithoutTimeout:   resourceRepositoryLinkRead,
+		UpdateWithoutTimeout: resourceRepositoryLinkUpdate,
+		DeleteWithoutTimeout: resourceRepositoryLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"repository_link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRepositoryLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	repositoryName := d.Get(names.AttrRepositoryName).(string)
+	input := &codestarconnections.CreateRepositoryLinkInput{
+		ConnectionArn:  aws.String(d.Get("connection_arn").(string)),
+		OwnerId:        aws.String(d.Get("owner_id").(string)),
+		RepositoryName: aws.String(repositoryName),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("encryption_key_arn"); ok {
+		input.EncryptionKeyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRepositoryLink(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeStar Connections Repository Link (%s): %s", repositoryName, err)
+	}
+
+	d.SetId(aws.ToString(output.RepositoryLinkInfo.RepositoryLinkId))
+
+	return append(diags, resourceRepositoryLinkRead(ctx, d, meta)...)
+}
+
+func resourceRepositoryLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	output, err := findRepositoryLinkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeStar Connections Repository Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeStar Connections Repository Link (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.RepositoryLinkArn)
+	d.Set("connection_arn", output.ConnectionArn)
+	d.Set("encryption_key_arn", output.EncryptionKeyArn)
+	d.Set("owner_id", output.OwnerId)
+	d.Set("provider_type", output.ProviderType)
+	d.Set("repository_link_id", output.RepositoryLinkId)
+	d.Set(names.AttrRepositoryName, output.RepositoryName)
+
+	return diags
+}
+
+func resourceRepositoryLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	if d.HasChanges("connection_arn", "encryption_key_arn") {
+		input := &codestarconnections.UpdateRepositoryLinkInput{
+			RepositoryLinkId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("connection_arn") {
+			input.ConnectionArn = aws.String(d.Get("connection_arn").(string))
+		}
+
+		if d.HasChange("encryption_key_arn") {
+			input.EncryptionKeyArn = aws.String(d.Get("encryption_key_arn").(string))
+		}
+
+		_, err := conn.UpdateRepositoryLink(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeStar Connections Repository Link (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRepositoryLinkRead(ctx, d, meta)...)
+}
+
+func resourceRepositoryLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CodeStar Connections Repository Link: %s", d.Id())
+	_, err := conn.DeleteRepositoryLink(ctx, &codestarconnections.DeleteRepositoryLinkInput{
+		RepositoryLinkId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeStar Connections Repository Link (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findRepositoryLinkByID(ctx context.Context, conn *codestarconnections.Client, id string) (*types.RepositoryLinkInfo, error) {
+	input := &codestarconnections.GetRepositoryLinkInput{
+		RepositoryLinkId: aws.String(id),
+	}
+
+	output, err := conn.GetRepositoryLink(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RepositoryLinkInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RepositoryLinkInfo, nil
+}
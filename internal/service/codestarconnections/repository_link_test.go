@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codestarconnections_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodestarconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Repository links require a connection in the AVAILABLE state, which can only be
+// reached by completing the provider handshake in the console.
+const (
+	envVarConnectionARN   = "CODESTARCONNECTIONS_CONNECTION_ARN"
+	envVarRepositoryOwner = "CODESTARCONNECTIONS_REPOSITORY_OWNER"
+	envVarRepositoryName  = "CODESTARCONNECTIONS_REPOSITORY_NAME"
+)
+
+func TestAccCodeStarConnectionsRepositoryLink_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	owner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	var v types.RepositoryLinkInfo
+	resourceName := "aws_codestarconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, owner, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "codestar-connections", regexache.MustCompile("repository-link/.+")),
+					resource.TestCheckResourceAttr(resourceName, "connection_arn", connectionARN),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "owner_id", owner),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_link_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRepositoryName, repositoryName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeStarConnectionsRepositoryLink_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	owner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	var v types.RepositoryLinkInfo
+	resourceName := "aws_codestarconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, owner, repositoryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodestarconnections.ResourceRepositoryLink(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeStarConnectionsRepositoryLink_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	owner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	var v types.RepositoryLinkInfo
+	resourceName := "aws_codestarconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_tags1(connectionARN, owner, repositoryName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRepositoryLinkConfig_tags2(connectionARN, owner, repositoryName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccRepositoryLinkConfig_tags1(connectionARN, owner, repositoryName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryLinkExists(ctx context.Context, n string, v *types.RepositoryLinkInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+		output, err := tfcodestarconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRepositoryLinkDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codestarconnections_repository_link" {
+				continue
+			}
+
+			_, err := tfcodestarconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeStar Connections Repository Link %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRepositoryLinkConfig_basic(connectionARN, owner, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+}
+`, connectionARN, owner, repositoryName)
+}
+
+func testAccRepositoryLinkConfig_tags1(connectionARN, owner, repositoryName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, connectionARN, owner, repositoryName, tagKey1, tagValue1)
+}
+
+func testAccRepositoryLinkConfig_tags2(connectionARN, owner, repositoryName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, connectionARN, owner, repositoryName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -9,6 +9,8 @@ var (
 	ResourcePoolProviderPrincipalTag = resourcePoolProviderPrincipalTag
 	ResourcePoolRolesAttachment      = resourcePoolRolesAttachment
 
+	FindPrincipalTagAttributeMapByTwoPartKey = findPrincipalTagAttributeMapByTwoPartKey
+
 	ValidIdentityPoolName                               = validIdentityPoolName
 	ValidIdentityProvidersClientID                      = validIdentityProvidersClientID
 	ValidIdentityProvidersProviderName                  = validIdentityProvidersProviderName
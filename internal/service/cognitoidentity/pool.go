@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -76,7 +77,6 @@ func resourcePool() *schema.Resource {
 			"developer_provider_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true, // Forcing a new resource since it cannot be edited afterwards
 				ValidateFunc: validProviderDeveloperName,
 			},
 
@@ -123,7 +123,13 @@ func resourcePool() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A developer provider name can be added to an existing pool but, once set, it cannot be changed or removed.
+			customdiff.ForceNewIfChange("developer_provider_name", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) != ""
+			}),
+		),
 	}
 }
 
@@ -236,6 +242,10 @@ func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			SamlProviderARNs:               flex.ExpandStringValueList(d.Get("saml_provider_arns").([]interface{})),
 		}
 
+		if v, ok := d.GetOk("developer_provider_name"); ok {
+			params.DeveloperProviderName = aws.String(v.(string))
+		}
+
 		_, err := conn.UpdateIdentityPool(ctx, params)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Cognito Identity Pool (%s): %s", d.Id(), err)
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -106,12 +108,9 @@ func resourcePoolProviderPrincipalTagRead(ctx context.Context, d *schema.Resourc
 		return create.AppendDiagError(diags, names.CognitoIdentity, create.ErrActionReading, ResNamePoolProviderPrincipalTag, d.Id(), err)
 	}
 
-	ret, err := conn.GetPrincipalTagAttributeMap(ctx, &cognitoidentity.GetPrincipalTagAttributeMapInput{
-		IdentityProviderName: aws.String(providerName),
-		IdentityPoolId:       aws.String(poolId),
-	})
+	ret, err := findPrincipalTagAttributeMapByTwoPartKey(ctx, conn, poolId, providerName)
 
-	if !d.IsNewResource() && errs.IsA[*awstypes.ResourceNotFoundException](err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		create.LogNotFoundRemoveState(names.CognitoIdentity, create.ErrActionReading, ResNamePoolProviderPrincipalTag, d.Id())
 		d.SetId("")
 		return diags
@@ -188,6 +187,32 @@ func resourcePoolProviderPrincipalTagDelete(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+func findPrincipalTagAttributeMapByTwoPartKey(ctx context.Context, conn *cognitoidentity.Client, poolID, providerName string) (*cognitoidentity.GetPrincipalTagAttributeMapOutput, error) {
+	input := &cognitoidentity.GetPrincipalTagAttributeMapInput{
+		IdentityPoolId:       aws.String(poolID),
+		IdentityProviderName: aws.String(providerName),
+	}
+
+	output, err := conn.GetPrincipalTagAttributeMap(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func DecodePoolProviderPrincipalTagsID(id string) (string, string, error) {
 	r := regexache.MustCompile(`(?P<ProviderID>[\w-]+:[0-9a-f-]+):(?P<ProviderName>[[:graph:]]+)`)
 	idParts := r.FindStringSubmatch(id)
@@ -9,16 +9,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
-	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfcognitoidentity "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidentity"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -141,10 +138,7 @@ func testAccCheckPoolProviderPrincipalTagsExists(ctx context.Context, n string)
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityClient(ctx)
 
-		_, err := conn.GetPrincipalTagAttributeMap(ctx, &cognitoidentity.GetPrincipalTagAttributeMapInput{
-			IdentityPoolId:       aws.String(rs.Primary.Attributes["identity_pool_id"]),
-			IdentityProviderName: aws.String(rs.Primary.Attributes["identity_provider_name"]),
-		})
+		_, err := tfcognitoidentity.FindPrincipalTagAttributeMapByTwoPartKey(ctx, conn, rs.Primary.Attributes["identity_pool_id"], rs.Primary.Attributes["identity_provider_name"])
 
 		return err
 	}
@@ -159,15 +153,13 @@ func testAccCheckPoolProviderPrincipalTagsDestroy(ctx context.Context) resource.
 				continue
 			}
 
-			_, err := conn.GetPrincipalTagAttributeMap(ctx, &cognitoidentity.GetPrincipalTagAttributeMapInput{
-				IdentityPoolId:       aws.String(rs.Primary.Attributes["identity_pool_id"]),
-				IdentityProviderName: aws.String(rs.Primary.Attributes["identity_provider_name"]),
-			})
+			_, err := tfcognitoidentity.FindPrincipalTagAttributeMapByTwoPartKey(ctx, conn, rs.Primary.Attributes["identity_pool_id"], rs.Primary.Attributes["identity_provider_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
 
 			if err != nil {
-				if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-					return nil
-				}
 				return err
 			}
 		}
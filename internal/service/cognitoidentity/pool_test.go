@@ -103,6 +103,47 @@ func TestAccCognitoIdentityPool_DeveloperProviderName(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIdentityPool_developerProviderNameAdded(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2, v3 cognitoidentity.DescribeIdentityPoolOutput
+	name := sdkacctest.RandString(10)
+	developerProviderName := sdkacctest.RandString(10)
+	developerProviderNameUpdated := sdkacctest.RandString(10)
+	resourceName := "aws_cognito_identity_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIdentityServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "developer_provider_name", ""),
+				),
+			},
+			{
+				Config: testAccPoolConfig_developerProviderName(name, developerProviderName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v2),
+					testAccCheckPoolNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "developer_provider_name", developerProviderName),
+				),
+			},
+			{
+				Config: testAccPoolConfig_developerProviderName(name, developerProviderNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v3),
+					testAccCheckPoolRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "developer_provider_name", developerProviderNameUpdated),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPool_supportedLoginProviders(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 cognitoidentity.DescribeIdentityPoolOutput
@@ -61,7 +61,7 @@ func ResourceCluster() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.ClusterEndpointEncryptionType](),
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// API returns "NONE" by default.
-					if old == string(awstypes.ClusterEndpointEncryptionTypeNone) && new == "" {
+					if none := string(awstypes.ClusterEndpointEncryptionTypeNone); (old == none && new == "") || (old == "" && new == none) {
 						return true
 					}
 
@@ -87,7 +87,7 @@ func ResourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"node_type": {
 				Type:     schema.TypeString,
@@ -131,8 +131,11 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidSecurityGroupID,
+				},
+				Set: schema.HashString,
 			},
 			"server_side_encryption": {
 				Type:     schema.TypeList,
@@ -175,6 +178,10 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cluster_endpoint_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"nodes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -196,6 +203,10 @@ func ResourceCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						names.AttrURL: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -343,6 +354,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set(names.AttrPort, c.ClusterDiscoveryEndpoint.Port)
 		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.ToString(c.ClusterDiscoveryEndpoint.Address), c.ClusterDiscoveryEndpoint.Port))
 		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+		d.Set("cluster_endpoint_url", c.ClusterDiscoveryEndpoint.URL)
 	}
 
 	d.Set("subnet_group_name", c.SubnetGroup)
@@ -354,10 +366,10 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.Set("maintenance_window", c.PreferredMaintenanceWindow)
 
-	if c.NotificationConfiguration != nil {
-		if aws.ToString(c.NotificationConfiguration.TopicStatus) == "active" {
-			d.Set("notification_topic_arn", c.NotificationConfiguration.TopicArn)
-		}
+	if c.NotificationConfiguration != nil && aws.ToString(c.NotificationConfiguration.TopicStatus) == notificationTopicStatusActive {
+		d.Set("notification_topic_arn", c.NotificationConfiguration.TopicArn)
+	} else {
+		d.Set("notification_topic_arn", "")
 	}
 
 	if err := setClusterNodeData(d, c); err != nil {
@@ -404,11 +416,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if d.HasChange("notification_topic_arn") {
-		v := d.Get("notification_topic_arn").(string)
-		req.NotificationTopicArn = aws.String(v)
-		if v == "" {
-			inactive := "inactive"
-			req.NotificationTopicStatus = &inactive
+		// A topic stays inactive until explicitly reactivated, so always send its status.
+		if o, n := d.GetChange("notification_topic_arn"); n.(string) != "" {
+			req.NotificationTopicArn = aws.String(n.(string))
+			req.NotificationTopicStatus = aws.String(notificationTopicStatusActive)
+		} else {
+			req.NotificationTopicArn = aws.String(o.(string))
+			req.NotificationTopicStatus = aws.String(notificationTopicStatusInactive)
 		}
 		requestUpdate = true
 	}
@@ -484,6 +498,7 @@ func setClusterNodeData(d *schema.ResourceData, c awstypes.Cluster) error {
 			names.AttrAddress:          aws.ToString(node.Endpoint.Address),
 			names.AttrPort:             node.Endpoint.Port,
 			names.AttrAvailabilityZone: aws.ToString(node.AvailabilityZone),
+			names.AttrURL:              aws.ToString(node.Endpoint.URL),
 		})
 	}
 
@@ -64,6 +64,10 @@ func TestAccDAXCluster_basic(t *testing.T) {
 						resourceName, "configuration_endpoint", regexache.MustCompile(`:\d+$`)),
 					resource.TestCheckResourceAttrSet(
 						resourceName, "cluster_address"),
+					resource.TestMatchResourceAttr(
+						resourceName, "cluster_endpoint_url", regexache.MustCompile(`^dax://`)),
+					resource.TestMatchResourceAttr(
+						resourceName, "nodes.0.url", regexache.MustCompile(`^dax://`)),
 					resource.TestMatchResourceAttr(
 						resourceName, names.AttrPort, regexache.MustCompile(`^\d+$`)),
 					resource.TestCheckResourceAttr(
@@ -253,6 +257,7 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName, &dc),
 					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", clusterEndpointEncryptionType),
+					resource.TestMatchResourceAttr(resourceName, "cluster_endpoint_url", regexache.MustCompile(`^daxs://`)),
 				),
 			},
 			{
@@ -270,6 +275,45 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 	})
 }
 
+func TestAccDAXCluster_notificationTopicARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dc awstypes.Cluster
+	rString := sdkacctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DAXServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_notificationTopicARN(rString, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_topic_arn", topicResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccClusterConfig_notificationTopicARN(rString, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc),
+					resource.TestCheckResourceAttr(resourceName, "notification_topic_arn", ""),
+				),
+			},
+			// Re-adding the topic must reactivate it.
+			{
+				Config: testAccClusterConfig_notificationTopicARN(rString, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_topic_arn", topicResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDAXCluster_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dc awstypes.Cluster
@@ -453,6 +497,22 @@ resource "aws_dax_cluster" "test" {
 `, baseConfig, rString, encryptionType)
 }
 
+func testAccClusterConfig_notificationTopicARN(rString string, enabled bool) string {
+	return fmt.Sprintf(`%[1]s
+resource "aws_sns_topic" "test" {
+  name = "tf-%[2]s"
+}
+
+resource "aws_dax_cluster" "test" {
+  cluster_name           = "tf-%[2]s"
+  iam_role_arn           = aws_iam_role.test.arn
+  node_type              = "dax.t3.small"
+  replication_factor     = 1
+  notification_topic_arn = %[3]t ? aws_sns_topic.test.arn : null
+}
+`, baseConfig, rString, enabled)
+}
+
 func testAccClusterConfig_resizeSingleNode(rString string) string {
 	return fmt.Sprintf(`%s
 resource "aws_dax_cluster" "test" {
@@ -17,6 +17,7 @@ func TestAccDetective_serial(t *testing.T) {
 		"Graph": {
 			acctest.CtBasic:      testAccGraph_basic,
 			acctest.CtDisappears: testAccGraph_disappears,
+			"datasourcePackages": testAccGraph_datasourcePackages,
 			"tags":               testAccGraph_tags,
 		},
 		"InvitationAccepter": {
@@ -26,6 +27,7 @@ func TestAccDetective_serial(t *testing.T) {
 			acctest.CtBasic: testAccMember_basic,
 			"disappear":     testAccMember_disappears,
 			"message":       testAccMember_message,
+			"dataSource":    testAccMemberDataSource_basic,
 		},
 		"OrganizationAdminAccount": {
 			acctest.CtBasic:      testAccOrganizationAdminAccount_basic,
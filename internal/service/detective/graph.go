@@ -5,6 +5,7 @@ package detective
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,10 +13,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/detective"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/detective/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -41,6 +47,15 @@ func ResourceGraph() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasource_packages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(optionalDatasourcePackageValues(), false),
+				},
+			},
 			"graph_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,8 +64,25 @@ func ResourceGraph() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceGraphDatasourcePackagesCustomizeDiff,
+		),
+	}
+}
+
+func resourceGraphDatasourcePackagesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("datasource_packages") || !d.NewValueKnown("datasource_packages") {
+		return nil
+	}
+
+	// Datasource packages cannot be stopped once started.
+	o, n := d.GetChange("datasource_packages")
+	if v := o.(*schema.Set).Difference(n.(*schema.Set)); v.Len() > 0 {
+		return fmt.Errorf("datasource_packages cannot be removed once enabled: %v", v.List())
 	}
+
+	return nil
 }
 
 func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -75,6 +107,12 @@ func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.ToString(outputRaw.(*detective.CreateGraphOutput).GraphArn))
 
+	if v, ok := d.GetOk("datasource_packages"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringyValueSet[awstypes.DatasourcePackage](v.(*schema.Set))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceGraphRead(ctx, d, meta)...)
 }
 
@@ -98,12 +136,44 @@ func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set(names.AttrCreatedTime, aws.ToTime(graph.CreatedTime).Format(time.RFC3339))
 	d.Set("graph_arn", graph.Arn)
 
+	datasourcePackages, err := findDatasourcePackagesByGraphARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Detective Graph (%s) datasource packages: %s", d.Id(), err)
+	}
+
+	var packages []string
+	for k, v := range datasourcePackages {
+		// DETECTIVE_CORE is always enabled.
+		if k == string(awstypes.DatasourcePackageDetectiveCore) {
+			continue
+		}
+
+		if v.DatasourcePackageIngestState == awstypes.DatasourcePackageIngestStateStarted {
+			packages = append(packages, k)
+		}
+	}
+	d.Set("datasource_packages", packages)
+
 	return diags
 }
 
 func resourceGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
-	return resourceGraphRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveClient(ctx)
+
+	if d.HasChange("datasource_packages") {
+		o, n := d.GetChange("datasource_packages")
+
+		if add := n.(*schema.Set).Difference(o.(*schema.Set)); add.Len() > 0 {
+			if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringyValueSet[awstypes.DatasourcePackage](add)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceGraphRead(ctx, d, meta)...)
 }
 
 func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -127,6 +197,21 @@ func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func updateDatasourcePackages(ctx context.Context, conn *detective.Client, graphARN string, datasourcePackages []awstypes.DatasourcePackage) error {
+	input := &detective.UpdateDatasourcePackagesInput{
+		DatasourcePackages: datasourcePackages,
+		GraphArn:           aws.String(graphARN),
+	}
+
+	_, err := conn.UpdateDatasourcePackages(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating Detective Graph (%s) datasource packages: %w", graphARN, err)
+	}
+
+	return nil
+}
+
 func FindGraphByARN(ctx context.Context, conn *detective.Client, arn string) (*awstypes.Graph, error) {
 	input := &detective.ListGraphsInput{}
 
@@ -166,3 +251,40 @@ func findGraphs(ctx context.Context, conn *detective.Client, input *detective.Li
 
 	return output, nil
 }
+
+func findDatasourcePackagesByGraphARN(ctx context.Context, conn *detective.Client, graphARN string) (map[string]awstypes.DatasourcePackageIngestDetail, error) {
+	input := &detective.ListDatasourcePackagesInput{
+		GraphArn: aws.String(graphARN),
+	}
+	output := make(map[string]awstypes.DatasourcePackageIngestDetail)
+
+	pages := detective.NewListDatasourcePackagesPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range page.DatasourcePackages {
+			output[k] = v
+		}
+	}
+
+	return output, nil
+}
+
+// optionalDatasourcePackageValues returns the datasource packages that can be enabled for a graph.
+func optionalDatasourcePackageValues() []string {
+	return tfslices.Filter(enum.Values[awstypes.DatasourcePackage](), func(v string) bool {
+		return v != string(awstypes.DatasourcePackageDetectiveCore)
+	})
+}
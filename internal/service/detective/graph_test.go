@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/detective/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -114,6 +115,47 @@ func testAccGraph_tags(t *testing.T) {
 	})
 }
 
+func testAccGraph_datasourcePackages(t *testing.T) {
+	ctx := acctest.Context(t)
+	var graph awstypes.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", string(awstypes.DatasourcePackageEksAudit)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"ASFF_SECURITYHUB_FINDING", "EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", string(awstypes.DatasourcePackageAsffSecurityhubFinding)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", string(awstypes.DatasourcePackageEksAudit)),
+				),
+			},
+			{
+				Config:      testAccGraphConfig_datasourcePackages(`"ASFF_SECURITYHUB_FINDING"`),
+				ExpectError: regexache.MustCompile(`datasource_packages cannot be removed once enabled`),
+			},
+		},
+	})
+}
+
 func testAccCheckGraphDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveClient(ctx)
@@ -167,6 +209,14 @@ resource "aws_detective_graph" "test" {}
 `
 }
 
+func testAccGraphConfig_datasourcePackages(datasourcePackages string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  datasource_packages = [%[1]s]
+}
+`, datasourcePackages)
+}
+
 func testAccGraphConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_detective_graph" "test" {
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_detective_member", name="Member")
+func DataSourceMember() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMemberRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"administrator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datasource_package_ingest_states": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"invitation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invited_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveClient(ctx)
+
+	graphARN, accountID := d.Get("graph_arn").(string), d.Get(names.AttrAccountID).(string)
+	member, err := FindMemberByGraphByTwoPartKey(ctx, conn, graphARN, accountID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Detective Member", err))
+	}
+
+	d.SetId(memberCreateResourceID(graphARN, accountID))
+	d.Set(names.AttrAccountID, member.AccountId)
+	d.Set("administrator_id", member.AdministratorId)
+	ingestStates := make(map[string]string, len(member.DatasourcePackageIngestStates))
+	for k, v := range member.DatasourcePackageIngestStates {
+		ingestStates[k] = string(v)
+	}
+	d.Set("datasource_package_ingest_states", ingestStates)
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("email_address", member.EmailAddress)
+	d.Set("graph_arn", member.GraphArn)
+	d.Set("invitation_type", member.InvitationType)
+	d.Set("invited_time", aws.ToTime(member.InvitedTime).Format(time.RFC3339))
+	d.Set(names.AttrStatus, member.Status)
+	d.Set("updated_time", aws.ToTime(member.UpdatedTime).Format(time.RFC3339))
+
+	return diags
+}
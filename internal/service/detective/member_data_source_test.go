@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMemberDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_detective_member.test"
+	dataSourceName := "data.aws_detective_member.test"
+	email := testAccMemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckMemberDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberDataSourceConfig_basic(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAccountID, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "administrator_id", resourceName, "administrator_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "email_address", resourceName, "email_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "graph_arn", resourceName, "graph_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invited_time", resourceName, "invited_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStatus, resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccMemberDataSourceConfig_basic(email string) string {
+	return acctest.ConfigCompose(testAccMemberConfig_basic(email), `
+data "aws_detective_member" "test" {
+  account_id = aws_detective_member.test.account_id
+  graph_arn  = aws_detective_member.test.graph_arn
+}
+`)
+}
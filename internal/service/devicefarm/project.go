@@ -11,12 +11,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/devicefarm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/devicefarm/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -52,9 +54,40 @@ func resourceProject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCConfig: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			// A project's VPC configuration can be changed but not removed.
+			customdiff.ForceNewIfChange(names.AttrVPCConfig, func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
 	}
 }
 
@@ -71,6 +104,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.DefaultJobTimeoutMinutes = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk(names.AttrVPCConfig); ok {
+		input.VpcConfig = expandProjectVPCConfig(v.([]interface{}))
+	}
+
 	output, err := conn.CreateProject(ctx, input)
 
 	if err != nil {
@@ -106,6 +143,9 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrName, project.Name)
 	d.Set(names.AttrARN, arn)
 	d.Set("default_job_timeout_minutes", project.DefaultJobTimeoutMinutes)
+	if err := d.Set(names.AttrVPCConfig, flattenProjectVPCConfig(project.VpcConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+	}
 
 	return diags
 }
@@ -127,6 +167,10 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DefaultJobTimeoutMinutes = aws.Int32(int32(d.Get("default_job_timeout_minutes").(int)))
 		}
 
+		if d.HasChange(names.AttrVPCConfig) {
+			input.VpcConfig = expandProjectVPCConfig(d.Get(names.AttrVPCConfig).([]interface{}))
+		}
+
 		_, err := conn.UpdateProject(ctx, input)
 
 		if err != nil {
@@ -180,3 +224,33 @@ func findProjectByARN(ctx context.Context, conn *devicefarm.Client, arn string)
 
 	return output.Project, nil
 }
+
+func expandProjectVPCConfig(l []interface{}) *awstypes.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &awstypes.VpcConfig{
+		SecurityGroupIds: flex.ExpandStringValueSet(m[names.AttrSecurityGroupIDs].(*schema.Set)),
+		SubnetIds:        flex.ExpandStringValueSet(m[names.AttrSubnetIDs].(*schema.Set)),
+		VpcId:            aws.String(m[names.AttrVPCID].(string)),
+	}
+
+	return config
+}
+
+func flattenProjectVPCConfig(conf *awstypes.VpcConfig) []interface{} {
+	if conf == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		names.AttrSecurityGroupIDs: flex.FlattenStringValueSet(conf.SecurityGroupIds),
+		names.AttrSubnetIDs:        flex.FlattenStringValueSet(conf.SubnetIds),
+		names.AttrVPCID:            aws.ToString(conf.VpcId),
+	}
+
+	return []interface{}{m}
+}
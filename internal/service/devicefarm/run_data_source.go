@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devicefarm
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/devicefarm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/devicefarm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_devicefarm_run", name="Run")
+func dataSourceRun() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRunRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"completed_jobs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"counters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"errored": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"passed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"skipped": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"stopped": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"warned": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stopped": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_jobs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeviceFarmClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	run, err := findRunByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DeviceFarm Run (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.ToString(run.Arn))
+	d.Set(names.AttrARN, run.Arn)
+	d.Set("completed_jobs", run.CompletedJobs)
+	if err := d.Set("counters", flattenCounters(run.Counters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting counters: %s", err)
+	}
+	if run.Created != nil {
+		d.Set("created", aws.ToTime(run.Created).Format(time.RFC3339))
+	}
+	d.Set(names.AttrMessage, run.Message)
+	d.Set(names.AttrName, run.Name)
+	d.Set("platform", run.Platform)
+	d.Set("result", run.Result)
+	d.Set("result_code", run.ResultCode)
+	if run.Started != nil {
+		d.Set("started", aws.ToTime(run.Started).Format(time.RFC3339))
+	}
+	d.Set(names.AttrStatus, run.Status)
+	if run.Stopped != nil {
+		d.Set("stopped", aws.ToTime(run.Stopped).Format(time.RFC3339))
+	}
+	d.Set("total_jobs", run.TotalJobs)
+	d.Set(names.AttrType, run.Type)
+
+	return diags
+}
+
+func findRunByARN(ctx context.Context, conn *devicefarm.Client, arn string) (*awstypes.Run, error) {
+	input := &devicefarm.GetRunInput{
+		Arn: aws.String(arn),
+	}
+	output, err := conn.GetRun(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Run == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Run, nil
+}
+
+func flattenCounters(apiObject *awstypes.Counters) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"errored": aws.ToInt32(apiObject.Errored),
+		"failed":  aws.ToInt32(apiObject.Failed),
+		"passed":  aws.ToInt32(apiObject.Passed),
+		"skipped": aws.ToInt32(apiObject.Skipped),
+		"stopped": aws.ToInt32(apiObject.Stopped),
+		"total":   aws.ToInt32(apiObject.Total),
+		"warned":  aws.ToInt32(apiObject.Warned),
+	}
+
+	return []interface{}{tfMap}
+}
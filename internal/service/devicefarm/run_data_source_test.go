@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devicefarm_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeviceFarmRunDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "DEVICEFARM_RUN_ARN"
+	runARN := os.Getenv(key)
+	if runARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	dataSourceName := "data.aws_devicefarm_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DeviceFarmEndpointID)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeviceFarmServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunDataSourceConfig_basic(runARN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrARN, runARN),
+					resource.TestCheckResourceAttr(dataSourceName, "counters.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccRunDataSourceConfig_basic(runARN string) string {
+	return fmt.Sprintf(`
+data "aws_devicefarm_run" "test" {
+  arn = %[1]q
+}
+`, runARN)
+}
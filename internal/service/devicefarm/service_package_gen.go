@@ -23,7 +23,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceRun,
+			TypeName: "aws_devicefarm_run",
+			Name:     "Run",
+		},
+		{
+			Factory:  dataSourceTestGridURL,
+			TypeName: "aws_devicefarm_test_grid_url",
+			Name:     "Test Grid URL",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
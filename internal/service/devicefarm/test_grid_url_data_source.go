@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devicefarm
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/devicefarm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_devicefarm_test_grid_url", name="Test Grid URL")
+func dataSourceTestGridURL() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTestGridURLRead,
+
+		Schema: map[string]*schema.Schema{
+			"expires": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrURL: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceTestGridURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeviceFarmClient(ctx)
+
+	projectARN := d.Get("project_arn").(string)
+	input := &devicefarm.CreateTestGridUrlInput{
+		ExpiresInSeconds: aws.Int32(int32(d.Get("expires_in_seconds").(int))),
+		ProjectArn:       aws.String(projectARN),
+	}
+
+	output, err := conn.CreateTestGridUrl(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DeviceFarm Test Grid URL (%s): %s", projectARN, err)
+	}
+
+	d.SetId(projectARN)
+	d.Set("expires", aws.ToTime(output.Expires).Format(time.RFC3339))
+	d.Set(names.AttrURL, output.Url)
+
+	return diags
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devicefarm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeviceFarmTestGridURLDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_devicefarm_test_grid_url.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DeviceFarmEndpointID)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeviceFarmServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestGridURLDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "expires"),
+					resource.TestCheckResourceAttr(dataSourceName, "expires_in_seconds", "600"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "project_arn", "aws_devicefarm_test_grid_project.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrURL),
+				),
+			},
+		},
+	})
+}
+
+func testAccTestGridURLDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_devicefarm_test_grid_project" "test" {
+  name = %[1]q
+}
+
+data "aws_devicefarm_test_grid_url" "test" {
+  project_arn        = aws_devicefarm_test_grid_project.test.arn
+  expires_in_seconds = 600
+}
+`, rName)
+}
@@ -44,6 +44,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DefaultPolicyTypeValues](),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -132,6 +138,31 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -174,6 +205,45 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"policy_language": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResourceTypeValues](),
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -216,6 +286,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 							Default:          awstypes.PolicyTypeValuesEbsSnapshotManagement,
 							ValidateDiagFunc: enum.Validate[awstypes.PolicyTypeValues](),
 						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 						names.AttrSchedule: {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -223,6 +299,48 @@ func resourceLifecyclePolicy() *schema.Resource {
 							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"archive_retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntBetween(1, 1000),
+																		},
+																		names.AttrInterval: {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ValidateDiagFunc: enum.Validate[awstypes.RetentionIntervalUnitValues](),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"copy_tags": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -330,9 +448,14 @@ func resourceLifecyclePolicy() *schema.Resource {
 												},
 												names.AttrTarget: {
 													Type:         schema.TypeString,
-													Required:     true,
+													Optional:     true,
 													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[\w:\-\/\*]+$`), ""),
 												},
+												"target_region": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidRegionName,
+												},
 											},
 										},
 									},
@@ -495,11 +618,15 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get(names.AttrDescription).(string)),
 		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
-		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{})),
+		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{}), d.Get("default_policy").(string)),
 		State:            awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string)),
 		Tags:             getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("default_policy"); ok {
+		input.DefaultPolicy = awstypes.DefaultPolicyTypeValues(v.(string))
+	}
+
 	out, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, createRetryTimeout, func() (interface{}, error) {
 		return conn.CreateLifecyclePolicy(ctx, &input)
 	})
@@ -531,6 +658,11 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set(names.AttrARN, out.Policy.PolicyArn)
+	if aws.ToBool(out.Policy.DefaultPolicy) && out.Policy.PolicyDetails != nil {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", nil)
+	}
 	d.Set(names.AttrDescription, out.Policy.Description)
 	d.Set(names.AttrExecutionRoleARN, out.Policy.ExecutionRoleArn)
 	d.Set(names.AttrState, out.Policy.State)
@@ -562,7 +694,7 @@ func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData,
 			input.State = awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string))
 		}
 		if d.HasChange("policy_details") {
-			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}))
+			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}), d.Get("default_policy").(string))
 		}
 
 		log.Printf("[INFO] Updating lifecycle policy %s", d.Id())
@@ -620,7 +752,7 @@ func findLifecyclePolicyByID(ctx context.Context, conn *dlm.Client, id string) (
 	return output, nil
 }
 
-func expandPolicyDetails(cfg []interface{}) *awstypes.PolicyDetails {
+func expandPolicyDetails(cfg []interface{}, defaultPolicy string) *awstypes.PolicyDetails {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
 	}
@@ -630,6 +762,33 @@ func expandPolicyDetails(cfg []interface{}) *awstypes.PolicyDetails {
 	policyDetails := &awstypes.PolicyDetails{
 		PolicyType: awstypes.PolicyTypeValues(policyType),
 	}
+
+	// Default policies use the simplified policy language.
+	if defaultPolicy != "" {
+		policyDetails.PolicyLanguage = awstypes.PolicyLanguageValuesSimplified
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(defaultPolicy)
+
+		if v, ok := m["copy_tags"].(bool); ok {
+			policyDetails.CopyTags = aws.Bool(v)
+		}
+		if v, ok := m["create_interval"].(int); ok && v > 0 {
+			policyDetails.CreateInterval = aws.Int32(int32(v))
+		}
+		if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+			policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+		}
+		if v, ok := m["exclusions"].([]interface{}); ok && len(v) > 0 {
+			policyDetails.Exclusions = expandExclusions(v)
+		}
+		if v, ok := m["extend_deletion"].(bool); ok {
+			policyDetails.ExtendDeletion = aws.Bool(v)
+		}
+		if v, ok := m["retain_interval"].(int); ok && v > 0 {
+			policyDetails.RetainInterval = aws.Int32(int32(v))
+		}
+	} else if v, ok := m[names.AttrResourceType].(string); ok && v != "" {
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(v)
+	}
 	if v, ok := m["resource_types"].([]interface{}); ok && len(v) > 0 {
 		policyDetails.ResourceTypes = flex.ExpandStringyValueList[awstypes.ResourceTypeValues](v)
 	}
@@ -664,11 +823,37 @@ func flattenPolicyDetails(policyDetails *awstypes.PolicyDetails) []map[string]in
 	result[names.AttrSchedule] = flattenSchedules(policyDetails.Schedules)
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = string(policyDetails.PolicyType)
+	result["policy_language"] = string(policyDetails.PolicyLanguage)
+	result[names.AttrResourceType] = string(policyDetails.ResourceType)
+
+	if policyDetails.CopyTags != nil {
+		result["copy_tags"] = aws.ToBool(policyDetails.CopyTags)
+	}
+
+	if policyDetails.CreateInterval != nil {
+		result["create_interval"] = aws.ToInt32(policyDetails.CreateInterval)
+	}
+
+	if policyDetails.CrossRegionCopyTargets != nil {
+		result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+	}
+
+	if policyDetails.Exclusions != nil {
+		result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	}
+
+	if policyDetails.ExtendDeletion != nil {
+		result["extend_deletion"] = aws.ToBool(policyDetails.ExtendDeletion)
+	}
 
 	if policyDetails.Parameters != nil {
 		result[names.AttrParameters] = flattenParameters(policyDetails.Parameters)
 	}
 
+	if policyDetails.RetainInterval != nil {
+		result["retain_interval"] = aws.ToInt32(policyDetails.RetainInterval)
+	}
+
 	return []map[string]interface{}{result}
 }
 
@@ -677,6 +862,9 @@ func expandSchedules(cfg []interface{}) []awstypes.Schedule {
 	for i, c := range cfg {
 		schedule := awstypes.Schedule{}
 		m := c.(map[string]interface{})
+		if v, ok := m["archive_rule"].([]interface{}); ok && len(v) > 0 {
+			schedule.ArchiveRule = expandArchiveRule(v)
+		}
 		if v, ok := m["copy_tags"]; ok {
 			schedule.CopyTags = aws.Bool(v.(bool))
 		}
@@ -718,6 +906,7 @@ func flattenSchedules(schedules []awstypes.Schedule) []map[string]interface{} {
 	result := make([]map[string]interface{}, len(schedules))
 	for i, s := range schedules {
 		m := make(map[string]interface{})
+		m["archive_rule"] = flattenArchiveRule(s.ArchiveRule)
 		m["copy_tags"] = aws.ToBool(s.CopyTags)
 		m["create_rule"] = flattenCreateRule(s.CreateRule)
 		m["cross_region_copy_rule"] = flattenCrossRegionCopyRules(s.CrossRegionCopyRules)
@@ -952,6 +1141,9 @@ func expandCrossRegionCopyRules(l []interface{}) []awstypes.CrossRegionCopyRule
 		if v, ok := m[names.AttrTarget].(string); ok && v != "" {
 			rule.Target = aws.String(v)
 		}
+		if v, ok := m["target_region"].(string); ok && v != "" {
+			rule.TargetRegion = aws.String(v)
+		}
 
 		rules = append(rules, rule)
 	}
@@ -974,6 +1166,7 @@ func flattenCrossRegionCopyRules(rules []awstypes.CrossRegionCopyRule) []interfa
 			names.AttrEncrypted: aws.ToBool(rule.Encrypted),
 			"retain_rule":       flattenCrossRegionCopyRuleRetainRule(rule.RetainRule),
 			names.AttrTarget:    aws.ToString(rule.Target),
+			"target_region":     aws.ToString(rule.TargetRegion),
 		}
 
 		result = append(result, m)
@@ -1034,6 +1227,178 @@ func flattenCrossRegionCopyRuleRetainRule(rule *awstypes.CrossRegionCopyRetainRu
 	return []interface{}{m}
 }
 
+func expandCrossRegionCopyTargets(l []interface{}) []awstypes.CrossRegionCopyTarget {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	var targets []awstypes.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		target := awstypes.CrossRegionCopyTarget{}
+
+		if v, ok := m["target_region"].(string); ok && v != "" {
+			target.TargetRegion = aws.String(v)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []awstypes.CrossRegionCopyTarget) []interface{} {
+	if len(targets) == 0 {
+		return []interface{}{}
+	}
+
+	var result []interface{}
+
+	for _, target := range targets {
+		m := map[string]interface{}{
+			"target_region": aws.ToString(target.TargetRegion),
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func expandExclusions(l []interface{}) *awstypes.Exclusions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	exclusions := &awstypes.Exclusions{}
+
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+
+	if v, ok := m["exclude_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+
+	if v, ok := m["exclude_volume_types"].([]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringValueList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *awstypes.Exclusions) []interface{} {
+	if exclusions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"exclude_boot_volumes": aws.ToBool(exclusions.ExcludeBootVolumes),
+		"exclude_tags":         flattenTags(exclusions.ExcludeTags),
+		"exclude_volume_types": flex.FlattenStringValueList(exclusions.ExcludeVolumeTypes),
+	}
+
+	return []interface{}{m}
+}
+
+func expandArchiveRule(l []interface{}) *awstypes.ArchiveRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	rule := &awstypes.ArchiveRule{}
+
+	if v, ok := m["archive_retain_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rule.RetainRule = expandArchiveRetainRule(v)
+	}
+
+	return rule
+}
+
+func flattenArchiveRule(rule *awstypes.ArchiveRule) []interface{} {
+	if rule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"archive_retain_rule": flattenArchiveRetainRule(rule.RetainRule),
+	}
+
+	return []interface{}{m}
+}
+
+func expandArchiveRetainRule(l []interface{}) *awstypes.ArchiveRetainRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	rule := &awstypes.ArchiveRetainRule{}
+
+	if v, ok := m["retention_archive_tier"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rule.RetentionArchiveTier = expandRetentionArchiveTier(v)
+	}
+
+	return rule
+}
+
+func flattenArchiveRetainRule(rule *awstypes.ArchiveRetainRule) []interface{} {
+	if rule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"retention_archive_tier": flattenRetentionArchiveTier(rule.RetentionArchiveTier),
+	}
+
+	return []interface{}{m}
+}
+
+func expandRetentionArchiveTier(l []interface{}) *awstypes.RetentionArchiveTier {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	tier := &awstypes.RetentionArchiveTier{}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		tier.Count = aws.Int32(int32(v))
+	}
+
+	if v, ok := m[names.AttrInterval].(int); ok && v > 0 {
+		tier.Interval = aws.Int32(int32(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		tier.IntervalUnit = awstypes.RetentionIntervalUnitValues(v)
+	}
+
+	return tier
+}
+
+func flattenRetentionArchiveTier(tier *awstypes.RetentionArchiveTier) []interface{} {
+	if tier == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"count":            aws.ToInt32(tier.Count),
+		names.AttrInterval: aws.ToInt32(tier.Interval),
+		"interval_unit":    string(tier.IntervalUnit),
+	}
+
+	return []interface{}{m}
+}
+
 func expandCreateRule(cfg []interface{}) *awstypes.CreateRule {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
@@ -5,6 +5,9 @@ package drs
 
 // Exports for use in tests only.
 const (
+	ResNameLaunchConfigurationTemplate        = "Launch Configuration Template"
 	ResNameReplicationConfigurationTemplate   = "Replication Configuration Template"
+	ResNameSourceNetwork                      = "Source Network"
+	ResPrefixLaunchConfigurationTemplate      = "LaunchConfigurationTemplate"
 	ResPrefixReplicationConfigurationTemplate = "ReplicationConfigurationTemplate"
 )
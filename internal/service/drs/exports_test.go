@@ -5,7 +5,11 @@ package drs
 
 // Exports for use in tests only.
 var (
+	ResourceLaunchConfigurationTemplate      = newLaunchConfigurationTemplateResource
 	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+	ResourceSourceNetwork                    = newSourceNetworkResource
 
+	FindLaunchConfigurationTemplateByID      = findLaunchConfigurationTemplateByID
 	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+	FindSourceNetworkByID                    = findSourceNetworkByID
 )
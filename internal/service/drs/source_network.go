@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Source Network")
+// @Tags(identifierAttribute="arn")
+func newSourceNetworkResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &sourceNetworkResource{}, nil
+}
+
+type sourceNetworkResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[sourceNetworkResourceModel]
+}
+
+func (r *sourceNetworkResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_drs_source_network"
+}
+
+func (r *sourceNetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cfn_stack_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"origin_account_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"origin_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sourceNetworkResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sourceNetworkResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	input := &drs.CreateSourceNetworkInput{
+		OriginAccountID: flex.StringFromFramework(ctx, data.OriginAccountID),
+		OriginRegion:    flex.StringFromFramework(ctx, data.OriginRegion),
+		Tags:            getTagsIn(ctx),
+		VpcID:           flex.StringFromFramework(ctx, data.VPCID),
+	}
+
+	output, err := conn.CreateSourceNetwork(ctx, input)
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionCreating, ResNameSourceNetwork, data.VPCID.ValueString(), err)
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.SourceNetworkID)
+
+	sourceNetwork, err := findSourceNetworkByID(ctx, conn, data.ID.ValueString())
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionReading, ResNameSourceNetwork, data.ID.ValueString(), err)
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, sourceNetwork.Arn)
+	data.CFNStackName = flex.StringToFramework(ctx, sourceNetwork.CfnStackName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceNetworkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sourceNetworkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	output, err := findSourceNetworkByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionReading, ResNameSourceNetwork, data.ID.ValueString(), err)
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.CFNStackName = flex.StringToFramework(ctx, output.CfnStackName)
+	data.OriginAccountID = flex.StringToFramework(ctx, output.SourceAccountID)
+	data.OriginRegion = flex.StringToFramework(ctx, output.SourceRegion)
+	data.VPCID = flex.StringToFramework(ctx, output.SourceVpcID)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceNetworkResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data sourceNetworkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	tflog.Debug(ctx, "deleting DRS Source Network", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := &drs.DeleteSourceNetworkInput{
+		SourceNetworkID: aws.String(data.ID.ValueString()),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, 5*time.Minute, func() (interface{}, error) {
+		return conn.DeleteSourceNetwork(ctx, input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionDeleting, ResNameSourceNetwork, data.ID.ValueString(), err)
+
+		return
+	}
+}
+
+func (r *sourceNetworkResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSourceNetwork(ctx context.Context, conn *drs.Client, input *drs.DescribeSourceNetworksInput) (*awstypes.SourceNetwork, error) {
+	output, err := findSourceNetworks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSourceNetworks(ctx context.Context, conn *drs.Client, input *drs.DescribeSourceNetworksInput) ([]awstypes.SourceNetwork, error) {
+	var output []awstypes.SourceNetwork
+
+	pages := drs.NewDescribeSourceNetworksPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findSourceNetworkByID(ctx context.Context, conn *drs.Client, id string) (*awstypes.SourceNetwork, error) {
+	input := &drs.DescribeSourceNetworksInput{
+		Filters: &awstypes.DescribeSourceNetworksRequestFilters{
+			SourceNetworkIDs: []string{id},
+		},
+	}
+
+	return findSourceNetwork(ctx, conn, input)
+}
+
+type sourceNetworkResourceModel struct {
+	ARN             types.String `tfsdk:"arn"`
+	CFNStackName    types.String `tfsdk:"cfn_stack_name"`
+	ID              types.String `tfsdk:"id"`
+	OriginAccountID types.String `tfsdk:"origin_account_id"`
+	OriginRegion    types.String `tfsdk:"origin_region"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	VPCID           types.String `tfsdk:"vpc_id"`
+}
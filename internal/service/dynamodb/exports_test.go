@@ -5,15 +5,16 @@ package dynamodb
 
 // Exports for use in tests only.
 var (
-	ResourceContributorInsights         = resourceContributorInsights
-	ResourceGlobalTable                 = resourceGlobalTable
-	ResourceKinesisStreamingDestination = resourceKinesisStreamingDestination
-	ResourceTable                       = resourceTable
-	ResourceTableExport                 = resourceTableExport
-	ResourceTableItem                   = resourceTableItem
-	ResourceTableReplica                = resourceTableReplica
-	ResourceTag                         = resourceTag
-	ResourceResourcePolicy              = newResourcePolicyResource
+	ResourceContributorInsights          = resourceContributorInsights
+	ResourceGlobalTable                  = resourceGlobalTable
+	ResourceKinesisStreamingDestination  = resourceKinesisStreamingDestination
+	ResourceKinesisStreamingDestinations = resourceKinesisStreamingDestinations
+	ResourceTable                        = resourceTable
+	ResourceTableExport                  = resourceTableExport
+	ResourceTableItem                    = resourceTableItem
+	ResourceTableReplica                 = resourceTableReplica
+	ResourceTag                          = resourceTag
+	ResourceResourcePolicy               = newResourcePolicyResource
 
 	ARNForNewRegion                              = arnForNewRegion
 	ContributorInsightsParseResourceID           = contributorInsightsParseResourceID
@@ -22,6 +23,7 @@ var (
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
 	FindGlobalTableByName                        = findGlobalTableByName
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
+	FindKinesisDataStreamDestinationsByTableName = findKinesisDataStreamDestinationsByTableName
 	FindResourcePolicyByARN                      = findResourcePolicyByARN
 	FindTableByName                              = findTableByName
 	FindTableExportByARN                         = findTableExportByARN
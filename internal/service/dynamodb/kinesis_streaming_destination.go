@@ -6,6 +6,7 @@ package dynamodb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -35,6 +36,7 @@ func resourceKinesisStreamingDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKinesisStreamingDestinationCreate,
 		ReadWithoutTimeout:   resourceKinesisStreamingDestinationRead,
+		UpdateWithoutTimeout: resourceKinesisStreamingDestinationUpdate,
 		DeleteWithoutTimeout: resourceKinesisStreamingDestinationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -42,6 +44,12 @@ func resourceKinesisStreamingDestination() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"approximate_creation_date_time_precision": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ApproximateCreationDateTimePrecision](),
+			},
 			names.AttrStreamARN: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -69,6 +77,12 @@ func resourceKinesisStreamingDestinationCreate(ctx context.Context, d *schema.Re
 		TableName: aws.String(tableName),
 	}
 
+	if v, ok := d.GetOk("approximate_creation_date_time_precision"); ok {
+		input.EnableKinesisStreamingConfiguration = &awstypes.EnableKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: awstypes.ApproximateCreationDateTimePrecision(v.(string)),
+		}
+	}
+
 	_, err := conn.EnableKinesisStreamingDestination(ctx, input)
 
 	if err != nil {
@@ -106,12 +120,31 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Kinesis Streaming Destination (%s): %s", d.Id(), err)
 	}
 
+	d.Set("approximate_creation_date_time_precision", output.ApproximateCreationDateTimePrecision)
 	d.Set(names.AttrStreamARN, output.StreamArn)
 	d.Set(names.AttrTableName, tableName)
 
 	return diags
 }
 
+func resourceKinesisStreamingDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), kinesisStreamingDestinationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tableName, streamARN := parts[0], parts[1]
+
+	if err := updateKinesisStreamingDestination(ctx, conn, streamARN, tableName, d.Get("approximate_creation_date_time_precision").(string)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return append(diags, resourceKinesisStreamingDestinationRead(ctx, d, meta)...)
+}
+
 func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
@@ -153,6 +186,28 @@ func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.Re
 	return diags
 }
 
+func updateKinesisStreamingDestination(ctx context.Context, conn *dynamodb.Client, streamARN, tableName, precision string) error {
+	input := &dynamodb.UpdateKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamARN),
+		TableName: aws.String(tableName),
+		UpdateKinesisStreamingConfiguration: &awstypes.UpdateKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: awstypes.ApproximateCreationDateTimePrecision(precision),
+		},
+	}
+
+	_, err := conn.UpdateKinesisStreamingDestination(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating DynamoDB Table (%s) Kinesis Streaming Destination (%s): %w", tableName, streamARN, err)
+	}
+
+	if _, err := waitKinesisStreamingDestinationActive(ctx, conn, streamARN, tableName); err != nil {
+		return fmt.Errorf("waiting for DynamoDB Table (%s) Kinesis Streaming Destination (%s) update: %w", tableName, streamARN, err)
+	}
+
+	return nil
+}
+
 func kinesisDataStreamDestinationForStream(arn string) tfslices.Predicate[awstypes.KinesisDataStreamDestination] {
 	return func(v awstypes.KinesisDataStreamDestination) bool {
 		return aws.ToString(v.StreamArn) == arn
@@ -231,7 +286,7 @@ func waitKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.C
 		timeout = 5 * time.Minute
 	)
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DestinationStatusDisabled, awstypes.DestinationStatusEnabling),
+		Pending: enum.Slice(awstypes.DestinationStatusDisabled, awstypes.DestinationStatusEnabling, awstypes.DestinationStatusUpdating),
 		Target:  enum.Slice(awstypes.DestinationStatusActive),
 		Timeout: timeout,
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamARN, tableName),
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dynamodb_kinesis_streaming_destinations", name="Kinesis Streaming Destinations")
+func resourceKinesisStreamingDestinations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKinesisStreamingDestinationsCreate,
+		ReadWithoutTimeout:   resourceKinesisStreamingDestinationsRead,
+		UpdateWithoutTimeout: resourceKinesisStreamingDestinationsUpdate,
+		DeleteWithoutTimeout: resourceKinesisStreamingDestinationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDestination: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      kinesisStreamingDestinationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"approximate_creation_date_time_precision": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ApproximateCreationDateTimePrecision](),
+						},
+						names.AttrStreamARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceKinesisStreamingDestinationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+
+	for _, tfMapRaw := range d.Get(names.AttrDestination).(*schema.Set).List() {
+		if err := enableKinesisStreamingDestination(ctx, conn, tableName, tfMapRaw.(map[string]interface{})); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	d.SetId(tableName)
+
+	return append(diags, resourceKinesisStreamingDestinationsRead(ctx, d, meta)...)
+}
+
+func resourceKinesisStreamingDestinationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	output, err := findKinesisDataStreamDestinationsByTableName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Kinesis Streaming Destinations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Kinesis Streaming Destinations (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(names.AttrDestination, flattenKinesisDataStreamDestinations(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting destination: %s", err)
+	}
+	d.Set(names.AttrTableName, d.Id())
+
+	return diags
+}
+
+func resourceKinesisStreamingDestinationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	o, n := d.GetChange(names.AttrDestination)
+	os, ns := kinesisStreamingDestinationsByStreamARN(o.(*schema.Set)), kinesisStreamingDestinationsByStreamARN(n.(*schema.Set))
+
+	// DynamoDB processes destination changes asynchronously.
+	// Apply them one at a time, waiting for each to complete, so that changes to multiple destinations don't race.
+	for streamARN := range os {
+		if _, ok := ns[streamARN]; ok {
+			continue
+		}
+
+		if err := disableKinesisStreamingDestination(ctx, conn, streamARN, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	for streamARN, tfMap := range ns {
+		old, ok := os[streamARN]
+
+		if !ok {
+			if err := enableKinesisStreamingDestination(ctx, conn, d.Id(), tfMap); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			continue
+		}
+
+		if precision := tfMap["approximate_creation_date_time_precision"].(string); precision != "" && precision != old["approximate_creation_date_time_precision"].(string) {
+			if err := updateKinesisStreamingDestination(ctx, conn, streamARN, d.Id(), precision); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceKinesisStreamingDestinationsRead(ctx, d, meta)...)
+}
+
+func resourceKinesisStreamingDestinationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	log.Printf("[DEBUG] Deleting DynamoDB Kinesis Streaming Destinations: %s", d.Id())
+	for streamARN := range kinesisStreamingDestinationsByStreamARN(d.Get(names.AttrDestination).(*schema.Set)) {
+		if err := disableKinesisStreamingDestination(ctx, conn, streamARN, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+func enableKinesisStreamingDestination(ctx context.Context, conn *dynamodb.Client, tableName string, tfMap map[string]interface{}) error {
+	streamARN := tfMap[names.AttrStreamARN].(string)
+	input := &dynamodb.EnableKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamARN),
+		TableName: aws.String(tableName),
+	}
+
+	if v, ok := tfMap["approximate_creation_date_time_precision"].(string); ok && v != "" {
+		input.EnableKinesisStreamingConfiguration = &awstypes.EnableKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: awstypes.ApproximateCreationDateTimePrecision(v),
+		}
+	}
+
+	_, err := conn.EnableKinesisStreamingDestination(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("enabling DynamoDB Table (%s) Kinesis Streaming Destination (%s): %w", tableName, streamARN, err)
+	}
+
+	if _, err := waitKinesisStreamingDestinationActive(ctx, conn, streamARN, tableName); err != nil {
+		return fmt.Errorf("waiting for DynamoDB Table (%s) Kinesis Streaming Destination (%s) enable: %w", tableName, streamARN, err)
+	}
+
+	return nil
+}
+
+func disableKinesisStreamingDestination(ctx context.Context, conn *dynamodb.Client, streamARN, tableName string) error {
+	_, err := conn.DisableKinesisStreamingDestination(ctx, &dynamodb.DisableKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamARN),
+		TableName: aws.String(tableName),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disabling DynamoDB Table (%s) Kinesis Streaming Destination (%s): %w", tableName, streamARN, err)
+	}
+
+	if _, err := waitKinesisStreamingDestinationDisabled(ctx, conn, streamARN, tableName); err != nil {
+		return fmt.Errorf("waiting for DynamoDB Table (%s) Kinesis Streaming Destination (%s) disable: %w", tableName, streamARN, err)
+	}
+
+	return nil
+}
+
+func findKinesisDataStreamDestinationsByTableName(ctx context.Context, conn *dynamodb.Client, tableName string) ([]awstypes.KinesisDataStreamDestination, error) {
+	input := &dynamodb.DescribeKinesisStreamingDestinationInput{
+		TableName: aws.String(tableName),
+	}
+
+	return findKinesisDataStreamDestinations(ctx, conn, input, func(v awstypes.KinesisDataStreamDestination) bool {
+		return v.DestinationStatus != awstypes.DestinationStatusDisabled && v.DestinationStatus != awstypes.DestinationStatusDisabling
+	})
+}
+
+func kinesisStreamingDestinationHash(v interface{}) int {
+	return create.StringHashcode(v.(map[string]interface{})[names.AttrStreamARN].(string))
+}
+
+func kinesisStreamingDestinationsByStreamARN(s *schema.Set) map[string]map[string]interface{} {
+	m := make(map[string]map[string]interface{}, s.Len())
+
+	for _, tfMapRaw := range s.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		m[tfMap[names.AttrStreamARN].(string)] = tfMap
+	}
+
+	return m
+}
+
+func flattenKinesisDataStreamDestinations(apiObjects []awstypes.KinesisDataStreamDestination) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"approximate_creation_date_time_precision": string(apiObject.ApproximateCreationDateTimePrecision),
+			names.AttrStreamARN:                        aws.ToString(apiObject.StreamArn),
+		})
+	}
+
+	return tfList
+}
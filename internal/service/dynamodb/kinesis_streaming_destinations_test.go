@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBKinesisStreamingDestinations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_kinesis_streaming_destinations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKinesisStreamingDestinationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamingDestinationsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "destination.*.stream_arn", "aws_kinesis_stream.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "destination.*.stream_arn", "aws_kinesis_stream.test.1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisStreamingDestinationsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "destination.*.stream_arn", "aws_kinesis_stream.test.1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "destination.*.stream_arn", "aws_kinesis_stream.test.2", names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "destination.*", map[string]string{
+						"approximate_creation_date_time_precision": "MICROSECOND",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBKinesisStreamingDestinations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_kinesis_streaming_destinations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKinesisStreamingDestinationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamingDestinationsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamingDestinationsExists(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdynamodb.ResourceKinesisStreamingDestinations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKinesisStreamingDestinationsExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		output, err := tfdynamodb.FindKinesisDataStreamDestinationsByTableName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("DynamoDB Table %s has %d Kinesis Streaming Destinations, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKinesisStreamingDestinationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_kinesis_streaming_destinations" {
+				continue
+			}
+
+			output, err := tfdynamodb.FindKinesisDataStreamDestinationsByTableName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("DynamoDB Kinesis Streaming Destinations %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccKinesisStreamingDestinationsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "hk"
+
+  attribute {
+    name = "hk"
+    type = "S"
+  }
+}
+
+resource "aws_kinesis_stream" "test" {
+  count = 3
+
+  name        = "%[1]s-${count.index}"
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccKinesisStreamingDestinationsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccKinesisStreamingDestinationsConfig_base(rName), `
+resource "aws_dynamodb_kinesis_streaming_destinations" "test" {
+  table_name = aws_dynamodb_table.test.name
+
+  destination {
+    stream_arn = aws_kinesis_stream.test[0].arn
+  }
+
+  destination {
+    stream_arn = aws_kinesis_stream.test[1].arn
+  }
+}
+`)
+}
+
+func testAccKinesisStreamingDestinationsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccKinesisStreamingDestinationsConfig_base(rName), `
+resource "aws_dynamodb_kinesis_streaming_destinations" "test" {
+  table_name = aws_dynamodb_table.test.name
+
+  destination {
+    stream_arn                               = aws_kinesis_stream.test[1].arn
+    approximate_creation_date_time_precision = "MICROSECOND"
+  }
+
+  destination {
+    stream_arn = aws_kinesis_stream.test[2].arn
+  }
+}
+`)
+}
@@ -57,6 +57,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_dynamodb_kinesis_streaming_destination",
 			Name:     "Kinesis Streaming Destination",
 		},
+		{
+			Factory:  resourceKinesisStreamingDestinations,
+			TypeName: "aws_dynamodb_kinesis_streaming_destinations",
+			Name:     "Kinesis Streaming Destinations",
+		},
 		{
 			Factory:  resourceTable,
 			TypeName: "aws_dynamodb_table",
@@ -272,6 +272,11 @@ func resourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"deletion_protection_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						names.AttrKMSKeyARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -302,6 +307,12 @@ func resourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"table_class_override": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.TableClass](),
+						},
 					},
 				},
 			},
@@ -1246,6 +1257,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			replicaInput.KMSMasterKeyId = aws.String(v)
 		}
 
+		if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+			replicaInput.TableClassOverride = awstypes.TableClass(v)
+		}
+
 		input := &dynamodb.UpdateTableInput{
 			TableName: aws.String(tableName),
 			ReplicaUpdates: []awstypes.ReplicationGroupUpdate{
@@ -1255,12 +1270,13 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 			},
 		}
 
-		// currently this would not be needed because (replica has these arguments):
+		// used for updates to (replica has these arguments):
 		//   region_name can't be updated - new replica
 		//   kms_key_arn can't be updated - remove/add replica
 		//   propagate_tags - handled elsewhere
 		//   point_in_time_recovery - handled elsewhere
-		// if provisioned_throughput_override or table_class_override were added, they could be updated here
+		//   deletion_protection_enabled - handled elsewhere
+		//   table_class_override - updated here
 		if !create {
 			var replicaInput = &awstypes.UpdateReplicationGroupMemberAction{}
 			if v, ok := tfMap["region_name"].(string); ok && v != "" {
@@ -1271,6 +1287,10 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 				replicaInput.KMSMasterKeyId = aws.String(v)
 			}
 
+			if v, ok := tfMap["table_class_override"].(string); ok && v != "" {
+				replicaInput.TableClassOverride = awstypes.TableClass(v)
+			}
+
 			input = &dynamodb.UpdateTableInput{
 				TableName: aws.String(tableName),
 				ReplicaUpdates: []awstypes.ReplicationGroupUpdate{
@@ -1326,6 +1346,13 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 		if err = updatePITR(ctx, conn, tableName, tfMap["point_in_time_recovery"].(bool), tfMap["region_name"].(string), timeout); err != nil {
 			return fmt.Errorf("updating replica (%s) point in time recovery: %w", tfMap["region_name"].(string), err)
 		}
+
+		// deletion protection
+		if v, ok := tfMap["deletion_protection_enabled"].(bool); ok && v {
+			if err := updateReplicaDeletionProtection(ctx, conn, tableName, v, tfMap["region_name"].(string), timeout); err != nil {
+				return fmt.Errorf("updating replica (%s) deletion protection: %w", tfMap["region_name"].(string), err)
+			}
+		}
 	}
 
 	return nil
@@ -1433,6 +1460,32 @@ func updatePITR(ctx context.Context, conn *dynamodb.Client, tableName string, en
 	return nil
 }
 
+func updateReplicaDeletionProtection(ctx context.Context, conn *dynamodb.Client, tableName string, enabled bool, region string, timeout time.Duration) error {
+	// deletion protection must be modified from region where the replica resides
+	log.Printf("[DEBUG] Updating DynamoDB replica deletion protection to %v (%s)", enabled, region)
+	input := &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(enabled),
+		TableName:                 aws.String(tableName),
+	}
+
+	optFn := func(o *dynamodb.Options) {
+		o.Region = region
+	}
+	_, err := tfresource.RetryWhenIsA[*awstypes.ResourceInUseException](ctx, max(replicaUpdateTimeout, timeout), func() (interface{}, error) {
+		return conn.UpdateTable(ctx, input, optFn)
+	})
+
+	if err != nil {
+		return fmt.Errorf("updating deletion protection: %w", err)
+	}
+
+	if _, err := waitReplicaActive(ctx, conn, tableName, region, timeout); err != nil {
+		return fmt.Errorf("waiting for deletion protection update: %w", err)
+	}
+
+	return nil
+}
+
 func updateReplica(ctx context.Context, conn *dynamodb.Client, d *schema.ResourceData) error {
 	oRaw, nRaw := d.GetChange("replica")
 	o := oRaw.(*schema.Set)
@@ -1499,15 +1552,28 @@ func updateReplica(ctx context.Context, conn *dynamodb.Client, d *schema.Resourc
 				break
 			}
 
+			// update table class in place
+			if v := ma["table_class_override"].(string); v != "" && v != mr["table_class_override"].(string) {
+				if err := createReplicas(ctx, conn, d.Id(), []interface{}{ma}, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) table class: %w", ma["region_name"].(string), err)
+				}
+			}
+
 			// just update PITR
 			if ma["point_in_time_recovery"].(bool) != mr["point_in_time_recovery"].(bool) {
 				if err := updatePITR(ctx, conn, d.Id(), ma["point_in_time_recovery"].(bool), ma["region_name"].(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 					return fmt.Errorf("updating replica (%s) point in time recovery: %w", ma["region_name"].(string), err)
 				}
-				break
 			}
 
-			// nothing changed, assuming propagate_tags changed so do nothing here
+			// just update deletion protection
+			if ma["deletion_protection_enabled"].(bool) != mr["deletion_protection_enabled"].(bool) {
+				if err := updateReplicaDeletionProtection(ctx, conn, d.Id(), ma["deletion_protection_enabled"].(bool), ma["region_name"].(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("updating replica (%s) deletion protection: %w", ma["region_name"].(string), err)
+				}
+			}
+
+			// nothing else changed, assuming propagate_tags changed so do nothing here
 			break
 		}
 	}
@@ -1820,6 +1886,7 @@ func enrichReplicas(ctx context.Context, conn *dynamodb.Client, arn, tableName s
 			continue
 		}
 
+		tfMap["deletion_protection_enabled"] = aws.ToBool(table.DeletionProtectionEnabled)
 		tfMap[names.AttrStreamARN] = aws.ToString(table.LatestStreamArn)
 		tfMap["stream_label"] = aws.ToString(table.LatestStreamLabel)
 
@@ -2046,6 +2113,10 @@ func flattenReplicaDescription(apiObject *awstypes.ReplicaDescription) map[strin
 		tfMap["region_name"] = aws.ToString(apiObject.RegionName)
 	}
 
+	if apiObject.ReplicaTableClassSummary != nil {
+		tfMap["table_class_override"] = string(apiObject.ReplicaTableClassSummary.TableClass)
+	}
+
 	return tfMap
 }
 
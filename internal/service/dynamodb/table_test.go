@@ -2024,6 +2024,58 @@ func TestAccDynamoDBTable_Replica_pitr(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_Replica_deletionProtectionAndTableClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf, replica1, replica2 awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_replicaDeletionProtectionAndTableClass(rName, true, string(awstypes.TableClassStandardInfrequentAccess)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					testAccCheckReplicaExists(ctx, resourceName, acctest.AlternateRegion(), &replica1),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"deletion_protection_enabled": acctest.CtTrue,
+						"region_name":                 acctest.AlternateRegion(),
+						"table_class_override":        string(awstypes.TableClassStandardInfrequentAccess),
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "table_class", string(awstypes.TableClassStandard)),
+				),
+			},
+			{
+				Config: testAccTableConfig_replicaDeletionProtectionAndTableClass(rName, false, string(awstypes.TableClassStandard)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					testAccCheckReplicaExists(ctx, resourceName, acctest.AlternateRegion(), &replica2),
+					testAccCheckTableNotRecreated(&replica1, &replica2),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"deletion_protection_enabled": acctest.CtFalse,
+						"region_name":                 acctest.AlternateRegion(),
+						"table_class_override":        string(awstypes.TableClassStandard),
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_Replica_pitrKMS(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3990,6 +4042,35 @@ resource "aws_dynamodb_table" "test" {
 `, rName, keyReplica1, keyReplica2))
 }
 
+func testAccTableConfig_replicaDeletionProtectionAndTableClass(rName string, deletionProtection bool, tableClass string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2), // Prevent "Provider configuration not present" errors
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  replica {
+    region_name                 = data.aws_region.alternate.name
+    deletion_protection_enabled = %[2]t
+    table_class_override        = %[3]q
+  }
+}
+`, rName, deletionProtection, tableClass))
+}
+
 func testAccTableConfig_replicaPITR(rName string, mainPITR, replica1, replica2 bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3), // Prevent "Provider configuration not present" errors
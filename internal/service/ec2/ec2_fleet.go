@@ -708,6 +708,11 @@ func resourceFleet() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsRFC3339Time,
 			},
+			"wait_for_fulfillment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -787,6 +792,13 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Instant fleets are fulfilled synchronously by CreateFleet.
+	if d.Get("wait_for_fulfillment").(bool) && fleetType != awstypes.FleetTypeInstant {
+		if _, err := waitFleetFulfilled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) fulfillment: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFleetRead(ctx, d, meta)...)
 }
 
@@ -898,6 +910,12 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		if err := waitFleet(ctx, conn, d.Id(), enum.Slice(awstypes.FleetStateCodeModifying), enum.Slice(awstypes.FleetStateCodeActive), d.Timeout(schema.TimeoutUpdate), 0); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) update: %s", d.Id(), err)
 		}
+
+		if d.Get("wait_for_fulfillment").(bool) && d.HasChange("target_capacity_specification.0.total_target_capacity") && awstypes.FleetType(d.Get(names.AttrType).(string)) == awstypes.FleetTypeMaintain {
+			if _, err := waitFleetFulfilled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) fulfillment: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceFleetRead(ctx, d, meta)...)
@@ -72,7 +72,7 @@ func TestAccEC2Fleet_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -126,7 +126,7 @@ func TestAccEC2Fleet_tags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -172,7 +172,7 @@ func TestAccEC2Fleet_excessCapacityTerminationPolicy(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_excessCapacityTerminationPolicy(rName, "termination"),
@@ -214,7 +214,7 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_launchTemplateID(
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateID(rName, launchTemplateResourceName2),
@@ -259,7 +259,7 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_launchTemplateNam
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateName(rName, launchTemplateResourceName2),
@@ -307,7 +307,7 @@ func TestAccEC2Fleet_LaunchTemplateLaunchTemplateSpecification_version(t *testin
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateVersion(rName, "t3.small"),
@@ -353,7 +353,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_availabilityZone(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideAvailabilityZone(rName, 1),
@@ -431,7 +431,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_memoryMiBAndVCP
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -461,7 +461,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_memoryMiBAndVCP
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -503,7 +503,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorCoun
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -532,7 +532,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorCoun
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -559,7 +559,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorCoun
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -599,7 +599,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManu
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -627,7 +627,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorManu
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -667,7 +667,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorName
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -698,7 +698,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorName
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -740,7 +740,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorTota
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -767,7 +767,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorTota
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -796,7 +796,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorTota
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -836,7 +836,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorType
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -863,7 +863,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_acceleratorType
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -903,7 +903,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_allowedInstance
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -930,7 +930,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_allowedInstance
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -969,7 +969,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_bareMetal(t *te
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -993,7 +993,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_bareMetal(t *te
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1017,7 +1017,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_bareMetal(t *te
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1059,7 +1059,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_baselineEBSBand
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1086,7 +1086,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_baselineEBSBand
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1115,7 +1115,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_baselineEBSBand
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1154,7 +1154,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_burstablePerfor
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1178,7 +1178,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_burstablePerfor
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1202,7 +1202,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_burstablePerfor
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1242,7 +1242,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_cpuManufacturer
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1269,7 +1269,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_cpuManufacturer
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1309,7 +1309,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_excludedInstanc
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1336,7 +1336,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_excludedInstanc
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1376,7 +1376,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_instanceGenerat
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1402,7 +1402,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_instanceGenerat
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1441,7 +1441,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_localStorage(t
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1465,7 +1465,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_localStorage(t
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1489,7 +1489,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_localStorage(t
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1529,7 +1529,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_localStorageTyp
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1555,7 +1555,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_localStorageTyp
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1594,7 +1594,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_maxSpotPriceAsP
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1636,7 +1636,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_memoryGiBPerVCP
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1663,7 +1663,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_memoryGiBPerVCP
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1692,7 +1692,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_memoryGiBPerVCP
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1734,7 +1734,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_networkBandwidt
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1761,7 +1761,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_networkBandwidt
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1790,7 +1790,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_networkBandwidt
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1832,7 +1832,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_networkInterfac
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1859,7 +1859,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_networkInterfac
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1888,7 +1888,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_networkInterfac
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1927,7 +1927,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_onDemandMaxPric
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -1966,7 +1966,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_requireHibernat
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -1990,7 +1990,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_requireHibernat
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -2029,7 +2029,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_spotMaxPricePer
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -2071,7 +2071,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_totalLocalStora
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -2098,7 +2098,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_totalLocalStora
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceRequirements(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix),
@@ -2127,7 +2127,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceRequirements_totalLocalStora
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -2158,7 +2158,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_instanceType(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideInstanceType(rName, "t3.medium"),
@@ -2199,7 +2199,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_maxPrice(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideMaxPrice(rName, "1.02"),
@@ -2267,7 +2267,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_priority(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverridePriority(rName, 2),
@@ -2309,7 +2309,7 @@ func TestAccEC2Fleet_LaunchTemplateOverridePriority_multiple(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverridePriorityMultiple(rName, 2, 1),
@@ -2353,7 +2353,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_subnetID(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideSubnetID(rName, 1),
@@ -2394,7 +2394,7 @@ func TestAccEC2Fleet_LaunchTemplateOverride_weightedCapacity(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideWeightedCapacity(rName, 2),
@@ -2436,7 +2436,7 @@ func TestAccEC2Fleet_LaunchTemplateOverrideWeightedCapacity_multiple(t *testing.
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_launchTemplateOverrideWeightedCapacityMultiple(rName, 1, 2),
@@ -2477,7 +2477,7 @@ func TestAccEC2Fleet_OnDemandOptions_allocationStrategy(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_onDemandOptionsAllocationStrategy(rName, "lowestPrice"),
@@ -2636,7 +2636,7 @@ func TestAccEC2Fleet_replaceUnhealthyInstances(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_replaceUnhealthyInstances(rName, false),
@@ -2674,7 +2674,7 @@ func TestAccEC2Fleet_SpotOptions_allocationStrategy(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_spotOptionsAllocationStrategy(rName, "lowestPrice"),
@@ -2719,7 +2719,7 @@ func TestAccEC2Fleet_SpotOptions_capacityRebalance(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -2767,7 +2767,7 @@ func TestAccEC2Fleet_SpotOptions_instanceInterruptionBehavior(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_spotOptionsInstanceInterruptionBehavior(rName, "terminate"),
@@ -2806,7 +2806,7 @@ func TestAccEC2Fleet_SpotOptions_instancePoolsToUseCount(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_spotOptionsInstancePoolsToUseCount(rName, 3),
@@ -2878,7 +2878,7 @@ func TestAccEC2Fleet_TargetCapacitySpecificationDefaultTargetCapacityType_onDema
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -2908,7 +2908,7 @@ func TestAccEC2Fleet_TargetCapacitySpecificationDefaultTargetCapacityType_spot(t
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -2938,7 +2938,7 @@ func TestAccEC2Fleet_TargetCapacitySpecification_totalTargetCapacity(t *testing.
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_targetCapacitySpecificationTotalTargetCapacity(rName, 2),
@@ -2953,6 +2953,45 @@ func TestAccEC2Fleet_TargetCapacitySpecification_totalTargetCapacity(t *testing.
 	})
 }
 
+func TestAccEC2Fleet_waitForFulfillment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet1, fleet2 awstypes.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_waitForFulfillment(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet1),
+					resource.TestCheckResourceAttr(resourceName, "fulfilled_capacity", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_fulfillment", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
+			},
+			{
+				Config: testAccFleetConfig_waitForFulfillment(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet2),
+					testAccCheckFleetNotRecreated(&fleet1, &fleet2),
+					resource.TestCheckResourceAttr(resourceName, "fulfilled_capacity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Fleet_TargetCapacitySpecification_targetCapacityUnitType(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fleet1 awstypes.FleetData
@@ -2978,7 +3017,7 @@ func TestAccEC2Fleet_TargetCapacitySpecification_targetCapacityUnitType(t *testi
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 		},
 	})
@@ -3007,7 +3046,7 @@ func TestAccEC2Fleet_terminateInstancesWithExpiration(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			{
 				Config: testAccFleetConfig_terminateInstancesExpiration(rName, false),
@@ -3046,7 +3085,7 @@ func TestAccEC2Fleet_type(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			// This configuration will fulfill immediately, skip until ValidFrom is implemented
 			// {
@@ -3091,7 +3130,7 @@ func TestAccEC2Fleet_type_instant(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances"},
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
 			},
 			// This configuration will fulfill immediately, skip until ValidFrom is implemented
 			// {
@@ -4313,6 +4352,31 @@ resource "aws_ec2_fleet" "test" {
 `, rName, totalTargetCapacity))
 }
 
+func testAccFleetConfig_waitForFulfillment(rName string, totalTargetCapacity int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
+resource "aws_ec2_fleet" "test" {
+  terminate_instances  = true
+  wait_for_fulfillment = true
+
+  launch_template_config {
+    launch_template_specification {
+      launch_template_id = aws_launch_template.test.id
+      version            = aws_launch_template.test.latest_version
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "on-demand"
+    total_target_capacity        = %[2]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, totalTargetCapacity))
+}
+
 func testAccFleetConfig_targetCapacitySpecificationTargetCapacityUnitType(rName string, totalTargetCapacity int, targetCapacityUnitType string) string {
 	return acctest.ConfigCompose(testAccFleetConfig_BaseLaunchTemplate(rName), fmt.Sprintf(`
 resource "aws_ec2_fleet" "test" {
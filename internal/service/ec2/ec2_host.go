@@ -71,6 +71,12 @@ func resourceHost() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"host_maintenance": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.HostMaintenance](),
+			},
 			"host_recovery": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -119,6 +125,10 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.AssetIds = []string{v.(string)}
 	}
 
+	if v, ok := d.GetOk("host_maintenance"); ok {
+		input.HostMaintenance = awstypes.HostMaintenance(v.(string))
+	}
+
 	if v, ok := d.GetOk("instance_family"); ok {
 		input.InstanceFamily = aws.String(v.(string))
 	}
@@ -173,6 +183,7 @@ func resourceHostRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("asset_id", host.AssetId)
 	d.Set("auto_placement", host.AutoPlacement)
 	d.Set(names.AttrAvailabilityZone, host.AvailabilityZone)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set(names.AttrInstanceType, host.HostProperties.InstanceType)
@@ -197,6 +208,10 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.AutoPlacement = awstypes.AutoPlacement(d.Get("auto_placement").(string))
 		}
 
+		if d.HasChange("host_maintenance") {
+			input.HostMaintenance = awstypes.HostMaintenance(d.Get("host_maintenance").(string))
+		}
+
 		if d.HasChange("host_recovery") {
 			input.HostRecovery = awstypes.HostRecovery(d.Get("host_recovery").(string))
 		}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_hosts", name="Hosts")
+func dataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHostsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_placement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available_instance_capacity": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"available_capacity": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									names.AttrInstanceType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"total_capacity": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"available_vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.DescribeHostsInput{}
+
+	if tags, ok := d.GetOk(names.AttrTags); ok {
+		input.Filter = append(input.Filter, newTagFilterList(
+			Tags(tftags.New(ctx, tags.(map[string]interface{}))),
+		)...)
+	}
+
+	input.Filter = append(input.Filter, newCustomFilterList(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filter) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filter = nil
+	}
+
+	output, err := findHosts(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Hosts: %s", err)
+	}
+
+	var hostIDs []string
+
+	for _, v := range output {
+		hostIDs = append(hostIDs, aws.ToString(v.HostId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("hosts", flattenHosts(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hosts: %s", err)
+	}
+	d.Set(names.AttrIDs, hostIDs)
+
+	return diags
+}
+
+func flattenHosts(apiObjects []awstypes.Host) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"auto_placement":           string(apiObject.AutoPlacement),
+			names.AttrAvailabilityZone: aws.ToString(apiObject.AvailabilityZone),
+			"host_id":                  aws.ToString(apiObject.HostId),
+			names.AttrState:            string(apiObject.State),
+		}
+
+		if v := apiObject.AvailableCapacity; v != nil {
+			tfMap["available_instance_capacity"] = flattenInstanceCapacities(v.AvailableInstanceCapacity)
+			tfMap["available_vcpus"] = aws.ToInt32(v.AvailableVCpus)
+		}
+
+		if v := apiObject.HostProperties; v != nil {
+			tfMap["instance_family"] = aws.ToString(v.InstanceFamily)
+			tfMap[names.AttrInstanceType] = aws.ToString(v.InstanceType)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenInstanceCapacities(apiObjects []awstypes.InstanceCapacity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"available_capacity":   aws.ToInt32(apiObject.AvailableCapacity),
+			names.AttrInstanceType: aws.ToString(apiObject.InstanceType),
+			"total_capacity":       aws.ToInt32(apiObject.TotalCapacity),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2HostsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_hosts.test"
+	resourceName := "aws_ec2_host.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "hosts.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hosts.0.host_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hosts.0.availability_zone", resourceName, names.AttrAvailabilityZone),
+					resource.TestCheckResourceAttrPair(dataSourceName, "hosts.0.instance_family", resourceName, "instance_family"),
+					resource.TestCheckResourceAttr(dataSourceName, "hosts.0.available_instance_capacity.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "hosts.0.available_instance_capacity.0.instance_type", "c5.large"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hosts.0.available_instance_capacity.0.available_capacity"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hosts.0.available_vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2HostsDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_hosts.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostsDataSourceConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "hosts.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "c5.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_hosts" "test" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_host.test]
+}
+`, rName))
+}
+
+func testAccHostsDataSourceConfig_empty(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_hosts" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -29,6 +29,10 @@ func resourceImageBlockPublicAccess() *schema.Resource {
 		UpdateWithoutTimeout: resourceImageBlockPublicAccessPut,
 		DeleteWithoutTimeout: schema.NoopContext,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
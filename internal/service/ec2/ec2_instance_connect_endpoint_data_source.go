@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ec2_instance_connect_endpoint", name="Instance Connect Endpoint")
+func newInstanceConnectEndpointDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &instanceConnectEndpointDataSource{}
+
+	return d, nil
+}
+
+type instanceConnectEndpointDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *instanceConnectEndpointDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ec2_instance_connect_endpoint"
+}
+
+func (d *instanceConnectEndpointDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDNSName: schema.StringAttribute{
+				Computed: true,
+			},
+			"fips_dns_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"network_interface_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrOwnerID: schema.StringAttribute{
+				Computed: true,
+			},
+			"preserve_client_ip": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrSecurityGroupIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrSubnetID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: customFiltersBlock(),
+		},
+	}
+}
+
+func (d *instanceConnectEndpointDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data instanceConnectEndpointDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	input := &ec2.DescribeInstanceConnectEndpointsInput{
+		Filters: append(newAttributeFilterList(
+			map[string]string{
+				"subnet-id": data.SubnetID.ValueString(),
+				"vpc-id":    data.VPCID.ValueString(),
+			},
+		), newCustomFilterListFramework(ctx, data.Filters)...),
+	}
+
+	if !data.InstanceConnectEndpointID.IsNull() {
+		input.InstanceConnectEndpointIds = []string{flex.StringValueFromFramework(ctx, data.InstanceConnectEndpointID)}
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	output, err := findInstanceConnectEndpoint(ctx, conn, input)
+
+	if err == nil && output.State == awstypes.Ec2InstanceConnectEndpointStateDeleteComplete {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Instance Connect Endpoint", tfresource.SingularDataSourceFindError("EC2 Instance Connect Endpoint", err).Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.InstanceConnectEndpointArn)
+	data.AvailabilityZone = flex.StringToFramework(ctx, output.AvailabilityZone)
+	data.DNSName = flex.StringToFramework(ctx, output.DnsName)
+	data.FIPSDNSName = flex.StringToFramework(ctx, output.FipsDnsName)
+	data.InstanceConnectEndpointID = flex.StringToFramework(ctx, output.InstanceConnectEndpointId)
+	data.NetworkInterfaceIDs = flex.FlattenFrameworkStringValueList(ctx, output.NetworkInterfaceIds)
+	data.OwnerID = flex.StringToFramework(ctx, output.OwnerId)
+	data.PreserveClientIP = flex.BoolToFramework(ctx, output.PreserveClientIp)
+	data.SecurityGroupIDs = flex.FlattenFrameworkStringValueSet(ctx, output.SecurityGroupIds)
+	data.State = flex.StringValueToFramework(ctx, output.State)
+	data.SubnetID = flex.StringToFramework(ctx, output.SubnetId)
+	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, keyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+	data.VPCID = flex.StringToFramework(ctx, output.VpcId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type instanceConnectEndpointDataSourceModel struct {
+	ARN                       types.String `tfsdk:"arn"`
+	AvailabilityZone          types.String `tfsdk:"availability_zone"`
+	DNSName                   types.String `tfsdk:"dns_name"`
+	FIPSDNSName               types.String `tfsdk:"fips_dns_name"`
+	Filters                   types.Set    `tfsdk:"filter"`
+	InstanceConnectEndpointID types.String `tfsdk:"id"`
+	NetworkInterfaceIDs       types.List   `tfsdk:"network_interface_ids"`
+	OwnerID                   types.String `tfsdk:"owner_id"`
+	PreserveClientIP          types.Bool   `tfsdk:"preserve_client_ip"`
+	SecurityGroupIDs          types.Set    `tfsdk:"security_group_ids"`
+	State                     types.String `tfsdk:"state"`
+	SubnetID                  types.String `tfsdk:"subnet_id"`
+	Tags                      types.Map    `tfsdk:"tags"`
+	VPCID                     types.String `tfsdk:"vpc_id"`
+}
@@ -274,6 +274,10 @@ func dataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"placement_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"placement_partition_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -477,6 +481,7 @@ func instanceDescriptionAttributes(ctx context.Context, d *schema.ResourceData,
 	d.Set("instance_state", instance.State.Name)
 	d.Set(names.AttrAvailabilityZone, instance.Placement.AvailabilityZone)
 	d.Set("placement_group", instance.Placement.GroupName)
+	d.Set("placement_group_id", instance.Placement.GroupId)
 	d.Set("placement_partition_number", instance.Placement.PartitionNumber)
 	d.Set("tenancy", instance.Placement.Tenancy)
 	d.Set("host_id", instance.Placement.HostId)
@@ -68,6 +68,11 @@ func resourceSpotInstanceRequest() *schema.Resource {
 			delete(s, "instance_market_options")
 			delete(s, "spot_instance_request_id")
 
+			// Spot placement does not support placement group IDs.
+			delete(s, "placement_group_id")
+			s["host_resource_group_arn"].ConflictsWith = []string{"placement_group"}
+			s["placement_group"].ConflictsWith = []string{"host_resource_group_arn"}
+
 			s["block_duration_minutes"] = &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -22,6 +22,7 @@ var (
 	ResourceEBSEncryptionByDefault                        = resourceEBSEncryptionByDefault
 	ResourceEBSFastSnapshotRestore                        = newEBSFastSnapshotRestoreResource
 	ResourceEBSSnapshot                                   = resourceEBSSnapshot
+	ResourceEBSSnapshotBlockPublicAccess                  = resourceEBSSnapshotBlockPublicAccess
 	ResourceEBSSnapshotCopy                               = resourceEBSSnapshotCopy
 	ResourceEBSSnapshotImport                             = resourceEBSSnapshotImport
 	ResourceEBSVolume                                     = resourceEBSVolume
@@ -78,6 +79,7 @@ var (
 	ResourceTag                                           = resourceTag
 	ResourceTrafficMirrorFilter                           = resourceTrafficMirrorFilter
 	ResourceTrafficMirrorFilterRule                       = resourceTrafficMirrorFilterRule
+	ResourceTrafficMirrorFilterRules                      = resourceTrafficMirrorFilterRules
 	ResourceTrafficMirrorSession                          = resourceTrafficMirrorSession
 	ResourceTrafficMirrorTarget                           = resourceTrafficMirrorTarget
 	ResourceTransitGatewayConnect                         = resourceTransitGatewayConnect
@@ -186,6 +188,7 @@ var (
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
+	FindSnapshotBlockPublicAccessState                         = findSnapshotBlockPublicAccessState
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
 	FindSpotFleetRequestByID                                   = findSpotFleetRequestByID
 	FindSpotFleetRequests                                      = findSpotFleetRequests
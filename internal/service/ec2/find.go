@@ -4253,6 +4253,21 @@ func findImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (*st
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func findSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (awstypes.SnapshotBlockPublicAccessState, error) {
+	input := &ec2.GetSnapshotBlockPublicAccessStateInput{}
+	output, err := conn.GetSnapshotBlockPublicAccessState(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.State, nil
+}
+
 func findImageLaunchPermissionsByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.LaunchPermission, error) {
 	input := &ec2.DescribeImageAttributeInput{
 		Attribute: awstypes.ImageAttributeNameLaunchPermission,
@@ -18,6 +18,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newCapacityBlockOfferingDataSource,
 			Name:    "Capacity Block Offering",
 		},
+		{
+			Factory: newInstanceConnectEndpointDataSource,
+			Name:    "Instance Connect Endpoint",
+		},
 		{
 			Factory: newSecurityGroupRuleDataSource,
 			Name:    "Security Group Rule",
@@ -166,6 +170,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Host",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceHosts,
+			TypeName: "aws_ec2_hosts",
+			Name:     "Hosts",
+		},
 		{
 			Factory:  dataSourceInstanceType,
 			TypeName: "aws_ec2_instance_type",
@@ -635,6 +644,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceEBSSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+			Name:     "EBS Snapshot Block Public Access",
+		},
 		{
 			Factory:  resourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -798,6 +812,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_traffic_mirror_filter_rule",
 			Name:     "Traffic Mirror Filter Rule",
 		},
+		{
+			Factory:  resourceTrafficMirrorFilterRules,
+			TypeName: "aws_ec2_traffic_mirror_filter_rules",
+			Name:     "Traffic Mirror Filter Rules",
+		},
 		{
 			Factory:  resourceTrafficMirrorSession,
 			TypeName: "aws_ec2_traffic_mirror_session",
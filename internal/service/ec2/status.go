@@ -81,6 +81,22 @@ func statusFleet(ctx context.Context, conn *ec2.Client, id string) retry.StateRe
 	}
 }
 
+func statusFleetActivityStatus(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFleetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ActivityStatus), nil
+	}
+}
+
 func statusHost(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findHostByID(ctx, conn, id)
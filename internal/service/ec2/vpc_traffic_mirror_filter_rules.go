@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_traffic_mirror_filter_rules", name="Traffic Mirror Filter Rules")
+func resourceTrafficMirrorFilterRules() *schema.Resource {
+	portRangeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from_port": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+					"to_port": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+				},
+			},
+		}
+	}
+	ruleSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrDescription: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"destination_cidr_block": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidCIDRNetworkAddress,
+					},
+					"destination_port_range": portRangeSchema(),
+					names.AttrProtocol: {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"rule_action": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.TrafficMirrorRuleAction](),
+					},
+					"source_cidr_block": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidCIDRNetworkAddress,
+					},
+					"source_port_range": portRangeSchema(),
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrafficMirrorFilterRulesCreate,
+		ReadWithoutTimeout:   resourceTrafficMirrorFilterRulesRead,
+		UpdateWithoutTimeout: resourceTrafficMirrorFilterRulesUpdate,
+		DeleteWithoutTimeout: resourceTrafficMirrorFilterRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"egress":  ruleSchema(),
+			"ingress": ruleSchema(),
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+var (
+	trafficMirrorFilterRulesDirections = map[string]awstypes.TrafficDirection{
+		"egress":  awstypes.TrafficDirectionEgress,
+		"ingress": awstypes.TrafficDirectionIngress,
+	}
+)
+
+func resourceTrafficMirrorFilterRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	filterID := d.Get("traffic_mirror_filter_id").(string)
+
+	for key, direction := range trafficMirrorFilterRulesDirections {
+		if err := syncTrafficMirrorFilterRules(ctx, conn, filterID, direction, nil, d.Get(key).([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 Traffic Mirror Filter (%s) Rules: %s", filterID, err)
+		}
+	}
+
+	d.SetId(filterID)
+
+	return append(diags, resourceTrafficMirrorFilterRulesRead(ctx, d, meta)...)
+}
+
+func resourceTrafficMirrorFilterRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	filter, err := findTrafficMirrorFilterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Traffic Mirror Filter (%s) Rules: %s", d.Id(), err)
+	}
+
+	if err := d.Set("egress", flattenTrafficMirrorFilterRules(filter.EgressFilterRules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+	}
+	if err := d.Set("ingress", flattenTrafficMirrorFilterRules(filter.IngressFilterRules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+	}
+	d.Set("traffic_mirror_filter_id", filter.TrafficMirrorFilterId)
+
+	return diags
+}
+
+func resourceTrafficMirrorFilterRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	for key, direction := range trafficMirrorFilterRulesDirections {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		o, n := d.GetChange(key)
+		if err := syncTrafficMirrorFilterRules(ctx, conn, d.Id(), direction, o.([]interface{}), n.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Traffic Mirror Filter (%s) Rules: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTrafficMirrorFilterRulesRead(ctx, d, meta)...)
+}
+
+func resourceTrafficMirrorFilterRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	for _, direction := range trafficMirrorFilterRulesDirections {
+		if err := syncTrafficMirrorFilterRules(ctx, conn, d.Id(), direction, nil, nil); err != nil {
+			if tfresource.NotFound(err) {
+				return diags
+			}
+
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Traffic Mirror Filter (%s) Rules: %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// syncTrafficMirrorFilterRules makes the filter's rules in the specified direction match the configured ordered list.
+// The rule at list index i is assigned rule number i+1. Existing rules are modified in place so that rule numbers never collide,
+// missing rules are created and any rules numbered beyond the end of the list are deleted.
+func syncTrafficMirrorFilterRules(ctx context.Context, conn *ec2.Client, filterID string, direction awstypes.TrafficDirection, o, n []interface{}) error {
+	filter, err := findTrafficMirrorFilterByID(ctx, conn, filterID)
+
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[int32]awstypes.TrafficMirrorFilterRule)
+	for _, v := range slices.Concat(filter.IngressFilterRules, filter.EgressFilterRules) {
+		if v.TrafficDirection == direction {
+			existing[aws.ToInt32(v.RuleNumber)] = v
+		}
+	}
+
+	for i, v := range n {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ruleNumber := int32(i + 1)
+
+		if rule, ok := existing[ruleNumber]; ok {
+			delete(existing, ruleNumber)
+
+			// Skip rules whose configuration is unchanged.
+			if i < len(o) && reflect.DeepEqual(o[i], v) {
+				continue
+			}
+
+			input := expandModifyTrafficMirrorFilterRuleInput(tfMap)
+			input.TrafficMirrorFilterRuleId = rule.TrafficMirrorFilterRuleId
+
+			if _, err := conn.ModifyTrafficMirrorFilterRule(ctx, input); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		input := expandCreateTrafficMirrorFilterRuleInput(tfMap)
+		input.ClientToken = aws.String(id.UniqueId())
+		input.RuleNumber = aws.Int32(ruleNumber)
+		input.TrafficDirection = direction
+		input.TrafficMirrorFilterId = aws.String(filterID)
+
+		if _, err := conn.CreateTrafficMirrorFilterRule(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range existing {
+		ruleID := aws.ToString(rule.TrafficMirrorFilterRuleId)
+
+		log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Filter Rule: %s", ruleID)
+		_, err := conn.DeleteTrafficMirrorFilterRule(ctx, &ec2.DeleteTrafficMirrorFilterRuleInput{
+			TrafficMirrorFilterRuleId: aws.String(ruleID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidTrafficMirrorFilterRuleIdNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandCreateTrafficMirrorFilterRuleInput(tfMap map[string]interface{}) *ec2.CreateTrafficMirrorFilterRuleInput {
+	apiObject := &ec2.CreateTrafficMirrorFilterRuleInput{
+		DestinationCidrBlock: aws.String(tfMap["destination_cidr_block"].(string)),
+		RuleAction:           awstypes.TrafficMirrorRuleAction(tfMap["rule_action"].(string)),
+		SourceCidrBlock:      aws.String(tfMap["source_cidr_block"].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DestinationPortRange = expandTrafficMirrorPortRangeRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(int); ok && v != 0 {
+		apiObject.Protocol = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["source_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePortRange = expandTrafficMirrorPortRangeRequest(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandModifyTrafficMirrorFilterRuleInput(tfMap map[string]interface{}) *ec2.ModifyTrafficMirrorFilterRuleInput {
+	apiObject := &ec2.ModifyTrafficMirrorFilterRuleInput{
+		DestinationCidrBlock: aws.String(tfMap["destination_cidr_block"].(string)),
+		RuleAction:           awstypes.TrafficMirrorRuleAction(tfMap["rule_action"].(string)),
+		SourceCidrBlock:      aws.String(tfMap["source_cidr_block"].(string)),
+	}
+
+	var removeFields []awstypes.TrafficMirrorFilterRuleField
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	} else {
+		removeFields = append(removeFields, awstypes.TrafficMirrorFilterRuleFieldDescription)
+	}
+
+	if v, ok := tfMap["destination_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DestinationPortRange = expandTrafficMirrorPortRangeRequest(v[0].(map[string]interface{}))
+	} else {
+		removeFields = append(removeFields, awstypes.TrafficMirrorFilterRuleFieldDestinationPortRange)
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(int); ok && v != 0 {
+		apiObject.Protocol = aws.Int32(int32(v))
+	} else {
+		removeFields = append(removeFields, awstypes.TrafficMirrorFilterRuleFieldProtocol)
+	}
+
+	if v, ok := tfMap["source_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePortRange = expandTrafficMirrorPortRangeRequest(v[0].(map[string]interface{}))
+	} else {
+		removeFields = append(removeFields, awstypes.TrafficMirrorFilterRuleFieldSourcePortRange)
+	}
+
+	if len(removeFields) > 0 {
+		apiObject.RemoveFields = removeFields
+	}
+
+	return apiObject
+}
+
+func flattenTrafficMirrorFilterRules(apiObjects []awstypes.TrafficMirrorFilterRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortFunc(apiObjects, func(a, b awstypes.TrafficMirrorFilterRule) int {
+		return int(aws.ToInt32(a.RuleNumber) - aws.ToInt32(b.RuleNumber))
+	})
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrDescription:    aws.ToString(apiObject.Description),
+			"destination_cidr_block": aws.ToString(apiObject.DestinationCidrBlock),
+			names.AttrProtocol:       aws.ToInt32(apiObject.Protocol),
+			"rule_action":            string(apiObject.RuleAction),
+			"source_cidr_block":      aws.ToString(apiObject.SourceCidrBlock),
+		}
+
+		if v := apiObject.DestinationPortRange; v != nil {
+			tfMap["destination_port_range"] = []interface{}{flattenTrafficMirrorPortRange(v)}
+		}
+
+		if v := apiObject.SourcePortRange; v != nil {
+			tfMap["source_port_range"] = []interface{}{flattenTrafficMirrorPortRange(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorFilterRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_traffic_mirror_filter_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilterRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficMirrorFilterRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRulesExists(ctx, resourceName, 2, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", "aws_ec2_traffic_mirror_filter.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.destination_cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.rule_action", "reject"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.rule_action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "egress.0.protocol", "6"),
+					resource.TestCheckResourceAttr(resourceName, "egress.0.destination_port_range.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "egress.0.destination_port_range.0.from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "egress.0.destination_port_range.0.to_port", "443"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesConfig_updated(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRulesExists(ctx, resourceName, 3, 0),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.destination_cidr_block", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.destination_cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.2.destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCTrafficMirrorFilterRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_traffic_mirror_filter_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilterRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficMirrorFilterRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRulesExists(ctx, resourceName, 2, 1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTrafficMirrorFilterRules(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficMirrorFilterRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_traffic_mirror_filter_rules" {
+				continue
+			}
+
+			output, err := tfec2.FindTrafficMirrorFilterByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if n := len(output.IngressFilterRules) + len(output.EgressFilterRules); n > 0 {
+				return fmt.Errorf("EC2 Traffic Mirror Filter %s still has %d rules", rs.Primary.ID, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrafficMirrorFilterRulesExists(ctx context.Context, n string, ingress, egress int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindTrafficMirrorFilterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output.IngressFilterRules), ingress; got != want {
+			return fmt.Errorf("EC2 Traffic Mirror Filter %s has %d ingress rules, want %d", rs.Primary.ID, got, want)
+		}
+
+		if got, want := len(output.EgressFilterRules), egress; got != want {
+			return fmt.Errorf("EC2 Traffic Mirror Filter %s has %d egress rules, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCTrafficMirrorFilterRulesConfig_basic() string {
+	return `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rules" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+
+  ingress {
+    destination_cidr_block = "10.0.0.0/8"
+    rule_action            = "reject"
+    source_cidr_block      = "0.0.0.0/0"
+  }
+
+  ingress {
+    destination_cidr_block = "0.0.0.0/0"
+    rule_action            = "accept"
+    source_cidr_block      = "0.0.0.0/0"
+  }
+
+  egress {
+    destination_cidr_block = "0.0.0.0/0"
+    protocol               = 6
+    rule_action            = "accept"
+    source_cidr_block      = "0.0.0.0/0"
+
+    destination_port_range {
+      from_port = 443
+      to_port   = 443
+    }
+  }
+}
+`
+}
+
+func testAccVPCTrafficMirrorFilterRulesConfig_updated() string {
+	return `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rules" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+
+  ingress {
+    description            = "first"
+    destination_cidr_block = "192.168.0.0/16"
+    rule_action            = "reject"
+    source_cidr_block      = "0.0.0.0/0"
+  }
+
+  ingress {
+    destination_cidr_block = "10.0.0.0/8"
+    rule_action            = "reject"
+    source_cidr_block      = "0.0.0.0/0"
+  }
+
+  ingress {
+    destination_cidr_block = "0.0.0.0/0"
+    rule_action            = "accept"
+    source_cidr_block      = "0.0.0.0/0"
+  }
+}
+`
+}
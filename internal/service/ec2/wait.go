@@ -520,6 +520,35 @@ func waitFleet(ctx context.Context, conn *ec2.Client, id string, pending, target
 	return err
 }
 
+func waitFleetFulfilled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.FleetData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.FleetActivityStatusPendingFulfillment),
+		Target:     enum.Slice(awstypes.FleetActivityStatusFulfilled),
+		Refresh:    statusFleetActivityStatus(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FleetData); ok {
+		if output.ActivityStatus == awstypes.FleetActivityStatusError {
+			var errs []error
+
+			for _, v := range output.Errors {
+				errs = append(errs, errors.New(aws.ToString(v.ErrorMessage)))
+			}
+
+			tfresource.SetLastError(err, errors.Join(errs...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitHostCreated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.Host, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.AllocationStatePending),
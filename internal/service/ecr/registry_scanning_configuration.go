@@ -5,6 +5,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -33,6 +34,8 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -148,6 +151,28 @@ func resourceRegistryScanningConfigurationDelete(ctx context.Context, d *schema.
 	return diags
 }
 
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("scan_type") || !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	// Continuous scanning is only available with enhanced scanning.
+	if scanType := types.ScanType(d.Get("scan_type").(string)); scanType == types.ScanTypeBasic {
+		for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if scanFrequency := types.ScanFrequency(tfMap["scan_frequency"].(string)); scanFrequency == types.ScanFrequencyContinuousScan {
+				return fmt.Errorf("rule scan_frequency %q is not supported with scan_type %q", scanFrequency, scanType)
+			}
+		}
+	}
+
+	return nil
+}
+
 func findRegistryScanningConfiguration(ctx context.Context, conn *ecr.Client) (*ecr.GetRegistryScanningConfigurationOutput, error) {
 	input := &ecr.GetRegistryScanningConfigurationInput{}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,8 +22,9 @@ func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic: testAccRegistryScanningConfiguration_basic,
-		"update":        testAccRegistryScanningConfiguration_update,
+		acctest.CtBasic:              testAccRegistryScanningConfiguration_basic,
+		"update":                     testAccRegistryScanningConfiguration_update,
+		"basicContinuousScanInvalid": testAccRegistryScanningConfiguration_basicContinuousScanInvalid,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -124,6 +126,23 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	})
 }
 
+func testAccRegistryScanningConfiguration_basicContinuousScanInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_basicContinuousScan(),
+				ExpectError: regexache.MustCompile(`rule scan_frequency "CONTINUOUS_SCAN" is not supported with scan_type "BASIC"`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationExists(ctx context.Context, n string, v *ecr.GetRegistryScanningConfigurationOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -189,3 +208,18 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 }
 `
 }
+
+func testAccRegistryScanningConfigurationConfig_basicContinuousScan() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "BASIC"
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchGetRepositoryScanningConfiguration accepts at most 25 repository names.
+	batchGetRepositoryScanningConfigurationMaxRepositoryNames = 25
+)
+
+// @FrameworkDataSource(name="Registry Scanning Coverage")
+func newRegistryScanningCoverageDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &registryScanningCoverageDataSource{}, nil
+}
+
+type registryScanningCoverageDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *registryScanningCoverageDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ecr_registry_scanning_coverage"
+}
+
+func (d *registryScanningCoverageDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"image_tag": schema.StringAttribute{
+				Optional: true,
+			},
+			"registry_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"repositories": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[repositoryScanningCoverageModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[repositoryScanningCoverageModel](ctx),
+			},
+			"repository_names": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"scan_type": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *registryScanningCoverageDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data registryScanningCoverageDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECRClient(ctx)
+
+	registryScanningConfiguration, err := findRegistryScanningConfiguration(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ECR Registry Scanning Configuration", err.Error())
+
+		return
+	}
+
+	var repositoryNames []string
+	if data.RepositoryNames.IsNull() || data.RepositoryNames.IsUnknown() {
+		repositories, err := findRepositories(ctx, conn, &ecr.DescribeRepositoriesInput{})
+
+		if err != nil {
+			response.Diagnostics.AddError("reading ECR Repositories", err.Error())
+
+			return
+		}
+
+		repositoryNames = tfslices.ApplyToAll(repositories, func(v awstypes.Repository) string {
+			return aws.ToString(v.RepositoryName)
+		})
+	} else {
+		repositoryNames = fwflex.ExpandFrameworkStringValueSet(ctx, data.RepositoryNames)
+	}
+
+	scanningConfigurations, err := findRepositoryScanningConfigurations(ctx, conn, repositoryNames)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ECR Repository Scanning Configurations", err.Error())
+
+		return
+	}
+
+	imageTag := data.ImageTag.ValueString()
+	var repositories []repositoryScanningCoverageModel
+	for _, apiObject := range scanningConfigurations {
+		repositoryName := aws.ToString(apiObject.RepositoryName)
+		repository := repositoryScanningCoverageModel{
+			AppliedScanFilters:    fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tfslices.ApplyToAll(apiObject.AppliedScanFilters, flattenScanningRepositoryFilterModel)),
+			FindingSeverityCounts: types.MapNull(types.Int64Type),
+			ImageScanStatus:       types.StringNull(),
+			RepositoryARN:         fwflex.StringToFramework(ctx, apiObject.RepositoryArn),
+			RepositoryName:        fwflex.StringValueToFramework(ctx, repositoryName),
+			ScanFrequency:         fwflex.StringValueToFramework(ctx, apiObject.ScanFrequency),
+			ScanOnPush:            types.BoolValue(apiObject.ScanOnPush),
+		}
+
+		if imageTag != "" {
+			output, err := findImageScanFindingsByTwoPartKey(ctx, conn, repositoryName, imageTag)
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image (%s:%s) scan findings", repositoryName, imageTag), err.Error())
+
+				return
+			default:
+				if v := output.ImageScanStatus; v != nil {
+					repository.ImageScanStatus = fwflex.StringValueToFramework(ctx, v.Status)
+				}
+
+				if v := output.ImageScanFindings; v != nil {
+					elements := make(map[string]attr.Value, len(v.FindingSeverityCounts))
+					for k, v := range v.FindingSeverityCounts {
+						elements[k] = types.Int64Value(int64(v))
+					}
+					repository.FindingSeverityCounts = types.MapValueMust(types.Int64Type, elements)
+				}
+			}
+		}
+
+		repositories = append(repositories, repository)
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+	data.RegistryID = fwflex.StringToFramework(ctx, registryScanningConfiguration.RegistryId)
+	data.Repositories = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, repositories)
+	data.RepositoryNames.SetValue = fwflex.FlattenFrameworkStringValueSet(ctx, repositoryNames)
+	if v := registryScanningConfiguration.ScanningConfiguration; v != nil {
+		data.ScanType = fwflex.StringValueToFramework(ctx, v.ScanType)
+	} else {
+		data.ScanType = types.StringNull()
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findRepositoryScanningConfigurations(ctx context.Context, conn *ecr.Client, repositoryNames []string) ([]awstypes.RepositoryScanningConfiguration, error) {
+	var output []awstypes.RepositoryScanningConfiguration
+
+	for _, chunk := range tfslices.Chunks(repositoryNames, batchGetRepositoryScanningConfigurationMaxRepositoryNames) {
+		input := &ecr.BatchGetRepositoryScanningConfigurationInput{
+			RepositoryNames: chunk,
+		}
+
+		page, err := conn.BatchGetRepositoryScanningConfiguration(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		var failures []error
+		for _, v := range page.Failures {
+			failures = append(failures, fmt.Errorf("%s: %s: %s", aws.ToString(v.RepositoryName), v.FailureCode, aws.ToString(v.FailureReason)))
+		}
+		if err := errors.Join(failures...); err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanningConfigurations...)
+	}
+
+	return output, nil
+}
+
+func findImageScanFindingsByTwoPartKey(ctx context.Context, conn *ecr.Client, repositoryName, imageTag string) (*ecr.DescribeImageScanFindingsOutput, error) {
+	input := &ecr.DescribeImageScanFindingsInput{
+		ImageId: &awstypes.ImageIdentifier{
+			ImageTag: aws.String(imageTag),
+		},
+		// Only the finding severity counts are used.
+		MaxResults:     aws.Int32(1),
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	output, err := conn.DescribeImageScanFindings(ctx, input)
+
+	if errs.IsA[*awstypes.ImageNotFoundException](err) || errs.IsA[*awstypes.RepositoryNotFoundException](err) || errs.IsA[*awstypes.ScanNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenScanningRepositoryFilterModel(apiObject awstypes.ScanningRepositoryFilter) scanningRepositoryFilterModel {
+	return scanningRepositoryFilterModel{
+		Filter:     types.StringPointerValue(apiObject.Filter),
+		FilterType: types.StringValue(string(apiObject.FilterType)),
+	}
+}
+
+type registryScanningCoverageDataSourceModel struct {
+	ID              types.String                                                     `tfsdk:"id"`
+	ImageTag        types.String                                                     `tfsdk:"image_tag"`
+	RegistryID      types.String                                                     `tfsdk:"registry_id"`
+	Repositories    fwtypes.ListNestedObjectValueOf[repositoryScanningCoverageModel] `tfsdk:"repositories"`
+	RepositoryNames fwtypes.SetValueOf[types.String]                                 `tfsdk:"repository_names"`
+	ScanType        types.String                                                     `tfsdk:"scan_type"`
+}
+
+type repositoryScanningCoverageModel struct {
+	AppliedScanFilters    fwtypes.ListNestedObjectValueOf[scanningRepositoryFilterModel] `tfsdk:"applied_scan_filters"`
+	FindingSeverityCounts types.Map                                                      `tfsdk:"finding_severity_counts"`
+	ImageScanStatus       types.String                                                   `tfsdk:"image_scan_status"`
+	RepositoryARN         types.String                                                   `tfsdk:"repository_arn"`
+	RepositoryName        types.String                                                   `tfsdk:"repository_name"`
+	ScanFrequency         types.String                                                   `tfsdk:"scan_frequency"`
+	ScanOnPush            types.Bool                                                     `tfsdk:"scan_on_push"`
+}
+
+type scanningRepositoryFilterModel struct {
+	Filter     types.String `tfsdk:"filter"`
+	FilterType types.String `tfsdk:"filter_type"`
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRRegistryScanningCoverageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_registry_scanning_coverage.test"
+	repositoryResourceName := "aws_ecr_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningCoverageDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "scan_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "repository_names.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "repositories.0.repository_arn", repositoryResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "repositories.0.repository_name", repositoryResourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "repositories.0.scan_frequency"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "repositories.0.scan_on_push"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRRegistryScanningCoverageDataSource_imageTag(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_registry_scanning_coverage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningCoverageDataSourceConfig_imageTag(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "image_tag", "latest"),
+					resource.TestCheckResourceAttr(dataSourceName, "repositories.#", acctest.Ct1),
+					// No image has been pushed to the repository.
+					resource.TestCheckNoResourceAttr(dataSourceName, "repositories.0.image_scan_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegistryScanningCoverageDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+
+  image_scanning_configuration {
+    scan_on_push = true
+  }
+}
+
+data "aws_ecr_registry_scanning_coverage" "test" {
+  repository_names = [aws_ecr_repository.test.name]
+}
+`, rName)
+}
+
+func testAccRegistryScanningCoverageDataSourceConfig_imageTag(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+data "aws_ecr_registry_scanning_coverage" "test" {
+  image_tag        = "latest"
+  repository_names = [aws_ecr_repository.test.name]
+}
+`, rName)
+}
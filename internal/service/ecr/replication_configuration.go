@@ -5,6 +5,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -33,6 +34,26 @@ func resourceReplicationConfiguration() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"effective_destination": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"registry_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"registry_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,6 +115,8 @@ func resourceReplicationConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceReplicationConfigurationCustomizeDiff,
 	}
 }
 
@@ -138,6 +161,9 @@ func resourceReplicationConfigurationRead(ctx context.Context, d *schema.Resourc
 	if err := d.Set("replication_configuration", flattenReplicationConfigurationReplicationConfiguration(output.ReplicationConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting replication_configuration: %s", err)
 	}
+	if err := d.Set("effective_destination", flattenReplicationConfigurationEffectiveDestinations(output.ReplicationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting effective_destination: %s", err)
+	}
 
 	return diags
 }
@@ -160,6 +186,39 @@ func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+func resourceReplicationConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("replication_configuration") {
+		return nil
+	}
+
+	// Replication to the source registry is rejected by the API, so catch it at plan time.
+	// Destination values that are not yet known are skipped.
+	client := meta.(*conns.AWSClient)
+	tfList, _ := diff.Get("replication_configuration.0.rule").([]interface{})
+	for i, rule := range expandReplicationConfigurationReplicationConfigurationRules(tfList) {
+		seen := make(map[string]struct{})
+
+		for _, destination := range rule.Destinations {
+			region, registryID := aws.ToString(destination.Region), aws.ToString(destination.RegistryId)
+			if region == "" || registryID == "" {
+				continue
+			}
+
+			if region == client.Region && registryID == client.AccountID {
+				return fmt.Errorf("replication_configuration rule %d: destination (%s, %s) is the source registry", i, region, registryID)
+			}
+
+			key := region + "/" + registryID
+			if _, ok := seen[key]; ok {
+				return fmt.Errorf("replication_configuration rule %d: duplicate destination (%s, %s)", i, region, registryID)
+			}
+			seen[key] = struct{}{}
+		}
+	}
+
+	return diff.SetNewComputed("effective_destination")
+}
+
 func findReplicationConfiguration(ctx context.Context, conn *ecr.Client) (*ecr.DescribeRegistryOutput, error) {
 	input := &ecr.DescribeRegistryInput{}
 
@@ -315,3 +374,44 @@ func flattenReplicationConfigurationReplicationConfigurationRulesRepositoryFilte
 
 	return tfList
 }
+
+// flattenReplicationConfigurationEffectiveDestinations lists the destinations that each repository prefix is replicated to.
+// Rules without repository filters apply to every repository and are reported with an empty prefix.
+func flattenReplicationConfigurationEffectiveDestinations(apiObject *types.ReplicationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+	seen := make(map[string]struct{})
+
+	for _, rule := range apiObject.Rules {
+		prefixes := []string{""}
+		if len(rule.RepositoryFilters) > 0 {
+			prefixes = nil
+			for _, filter := range rule.RepositoryFilters {
+				prefixes = append(prefixes, aws.ToString(filter.Filter))
+			}
+		}
+
+		for _, prefix := range prefixes {
+			for _, destination := range rule.Destinations {
+				region, registryID := aws.ToString(destination.Region), aws.ToString(destination.RegistryId)
+
+				key := prefix + "/" + region + "/" + registryID
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+
+				tfList = append(tfList, map[string]interface{}{
+					names.AttrRegion:    region,
+					"registry_id":       registryID,
+					"repository_prefix": prefix,
+				})
+			}
+		}
+	}
+
+	return tfList
+}
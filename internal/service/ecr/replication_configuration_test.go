@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -23,7 +24,9 @@ func TestAccECRReplicationConfiguration_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		acctest.CtBasic:      testAccReplicationConfiguration_basic,
 		acctest.CtDisappears: testAccReplicationConfiguration_disappears,
+		"multipleRules":      testAccReplicationConfiguration_multipleRules,
 		"repositoryFilter":   testAccReplicationConfiguration_repositoryFilter,
+		"sourceDestination":  testAccReplicationConfiguration_sourceDestination,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -170,6 +173,58 @@ func testAccReplicationConfiguration_repositoryFilter(t *testing.T) {
 	})
 }
 
+func testAccReplicationConfiguration_multipleRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ecr_replication_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationConfig_multipleRules(acctest.AlternateRegion(), acctest.ThirdRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter", "a-prefix"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.1.repository_filter.0.filter", "b-prefix"),
+					resource.TestCheckResourceAttr(resourceName, "effective_destination.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "effective_destination.0.repository_prefix", "a-prefix"),
+					resource.TestCheckResourceAttr(resourceName, "effective_destination.0.region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "effective_destination.1.repository_prefix", "b-prefix"),
+					resource.TestCheckResourceAttr(resourceName, "effective_destination.1.region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "effective_destination.2.repository_prefix", "b-prefix"),
+					resource.TestCheckResourceAttr(resourceName, "effective_destination.2.region", acctest.ThirdRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccReplicationConfiguration_sourceDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicationConfigurationConfig_basic(acctest.Region()),
+				ExpectError: regexache.MustCompile(`is the source registry`),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -299,3 +354,42 @@ resource "aws_ecr_replication_configuration" "test" {
 }
 `, region)
 }
+
+func testAccReplicationConfigurationConfig_multipleRules(region1, region2 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      repository_filter {
+        filter      = "a-prefix"
+        filter_type = "PREFIX_MATCH"
+      }
+    }
+
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      destination {
+        region      = %[2]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      repository_filter {
+        filter      = "b-prefix"
+        filter_type = "PREFIX_MATCH"
+      }
+    }
+  }
+}
+`, region1, region2)
+}
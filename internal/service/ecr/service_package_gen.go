@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newLifecyclePolicyDocumentDataSource,
 			Name:    "Lifecycle Policy Document",
 		},
+		{
+			Factory: newRegistryScanningCoverageDataSource,
+			Name:    "Registry Scanning Coverage",
+		},
 		{
 			Factory: newRepositoriesDataSource,
 			Name:    "Repositories",
@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,7 +45,10 @@ func resourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			addonConfigurationValuesCustomizeDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -356,6 +361,32 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	return output.Addon, nil
 }
 
+func findAddonConfigurationByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (*eks.DescribeAddonConfigurationOutput, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.ToString(output.ConfigurationSchema) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -478,6 +509,41 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterNam
 	return nil, err
 }
 
+// addonConfigurationValuesCustomizeDiff validates JSON configuration values against the add-on version's
+// configuration schema so that typos are caught at plan time instead of leaving the add-on DEGRADED.
+func addonConfigurationValuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	// The add-on version is only known at plan time if it is configured.
+	if !d.NewValueKnown("addon_version") || !d.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	addonName, addonVersion, configurationValues := d.Get("addon_name").(string), d.Get("addon_version").(string), d.Get("configuration_values").(string)
+
+	// YAML configuration values are passed through unvalidated.
+	if addonName == "" || addonVersion == "" || !json.Valid([]byte(configurationValues)) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	output, err := findAddonConfigurationByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read EKS Add-On (%s) version (%s) configuration schema, skipping configuration_values validation: %s", addonName, addonVersion, err)
+		return nil
+	}
+
+	if err := validAddonConfigurationValues(aws.ToString(output.ConfigurationSchema), configurationValues); err != nil {
+		return fmt.Errorf("configuration_values are not valid for EKS Add-On (%s) version (%s): %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
+
 func addonIssueError(apiObject types.AddonIssue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
@@ -150,7 +150,7 @@ func resourceNodeGroup() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 			"release_version": {
 				Type:     schema.TypeString,
@@ -235,7 +235,10 @@ func resourceNodeGroup() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				MinItems: 1,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidSubnetID,
+				},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -458,7 +461,44 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	// Do any version update first.
+	if d.HasChanges("labels", "scaling_config", "taint", "update_config") {
+		oldLabelsRaw, newLabelsRaw := d.GetChange("labels")
+		oldTaintsRaw, newTaintsRaw := d.GetChange("taint")
+
+		input := &eks.UpdateNodegroupConfigInput{
+			ClientRequestToken: aws.String(id.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			Labels:             expandUpdateLabelsPayload(ctx, oldLabelsRaw, newLabelsRaw),
+			NodegroupName:      aws.String(nodeGroupName),
+			Taints:             expandUpdateTaintsPayload(oldTaintsRaw.(*schema.Set).List(), newTaintsRaw.(*schema.Set).List()),
+		}
+
+		if d.HasChange("scaling_config") {
+			if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ScalingConfig = expandNodegroupScalingConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("update_config") {
+			if v, ok := d.GetOk("update_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.UpdateConfig = expandNodegroupUpdateConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		output, err := conn.UpdateNodegroupConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) config: %s", d.Id(), err)
+		}
+
+		updateID := aws.ToString(output.Update.Id)
+
+		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) config update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
+	// Do any version update last so that it is rolled out using the latest update_config.
 	if d.HasChanges(names.AttrLaunchTemplate, "release_version", names.AttrVersion) {
 		input := &eks.UpdateNodegroupVersionInput{
 			ClientRequestToken: aws.String(id.UniqueId()),
@@ -504,44 +544,14 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		updateID := aws.ToString(output.Update.Id)
 
 		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
-		}
-	}
-
-	if d.HasChanges("labels", "scaling_config", "taint", "update_config") {
-		oldLabelsRaw, newLabelsRaw := d.GetChange("labels")
-		oldTaintsRaw, newTaintsRaw := d.GetChange("taint")
+			// Leave the previous version attributes in state so that the failed version update is retried on the next apply.
+			d.Partial(true)
 
-		input := &eks.UpdateNodegroupConfigInput{
-			ClientRequestToken: aws.String(id.UniqueId()),
-			ClusterName:        aws.String(clusterName),
-			Labels:             expandUpdateLabelsPayload(ctx, oldLabelsRaw, newLabelsRaw),
-			NodegroupName:      aws.String(nodeGroupName),
-			Taints:             expandUpdateTaintsPayload(oldTaintsRaw.(*schema.Set).List(), newTaintsRaw.(*schema.Set).List()),
-		}
-
-		if d.HasChange("scaling_config") {
-			if v, ok := d.GetOk("scaling_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				input.ScalingConfig = expandNodegroupScalingConfig(v.([]interface{})[0].(map[string]interface{}))
+			if !input.Force && errs.Contains(err, string(types.ErrorCodePodEvictionFailure)) {
+				return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s; set force_update_version to true to force the update", d.Id(), updateID, err)
 			}
-		}
-
-		if d.HasChange("update_config") {
-			if v, ok := d.GetOk("update_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				input.UpdateConfig = expandNodegroupUpdateConfig(v.([]interface{})[0].(map[string]interface{}))
-			}
-		}
-
-		output, err := conn.UpdateNodegroupConfig(ctx, input)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) config: %s", d.Id(), err)
-		}
-
-		updateID := aws.ToString(output.Update.Id)
-
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) config update (%s): %s", d.Id(), updateID, err)
+			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
 		}
 	}
 
@@ -513,6 +513,47 @@ func TestAccEKSNodeGroup_LaunchTemplate_version(t *testing.T) {
 	})
 }
 
+func TestAccEKSNodeGroup_LaunchTemplate_versionUpdateConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var nodeGroup1, nodeGroup2 types.Nodegroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	launchTemplateResourceName := "aws_launch_template.test"
+	resourceName := "aws_eks_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeGroupConfig_launchTemplateVersionUpdateConfig(rName, "t3.medium", 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup1),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.version", launchTemplateResourceName, "default_version"),
+					resource.TestCheckResourceAttr(resourceName, "update_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable_percentage", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNodeGroupConfig_launchTemplateVersionUpdateConfig(rName, "t3.large", 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup2),
+					testAccCheckNodeGroupNotRecreated(&nodeGroup1, &nodeGroup2),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.version", launchTemplateResourceName, "default_version"),
+					resource.TestCheckResourceAttr(resourceName, "update_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "update_config.0.max_unavailable_percentage", "100"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEKSNodeGroup_releaseVersion(t *testing.T) {
 	ctx := acctest.Context(t)
 	var nodeGroup1, nodeGroup2 types.Nodegroup
@@ -1808,6 +1849,52 @@ resource "aws_eks_node_group" "test" {
 `, rName))
 }
 
+func testAccNodeGroupConfig_launchTemplateVersionUpdateConfig(rName, instanceType string, maxUnavailablePercentage int) string {
+	return acctest.ConfigCompose(
+		testAccNodeGroupBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_ssm_parameter" "test" {
+  name = "/aws/service/eks/optimized-ami/${aws_eks_cluster.test.version}/amazon-linux-2/recommended/image_id"
+}
+
+resource "aws_launch_template" "test" {
+  image_id               = data.aws_ssm_parameter.test.value
+  instance_type          = %[2]q
+  name                   = %[1]q
+  update_default_version = true
+  user_data              = base64encode(templatefile("testdata/node-group-launch-template-user-data.sh.tmpl", { cluster_name = aws_eks_cluster.test.name }))
+}
+
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  launch_template {
+    name    = aws_launch_template.test.name
+    version = aws_launch_template.test.default_version
+  }
+
+  scaling_config {
+    desired_size = 2
+    max_size     = 2
+    min_size     = 2
+  }
+
+  update_config {
+    max_unavailable_percentage = %[3]d
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+  ]
+}
+`, rName, instanceType, maxUnavailablePercentage))
+}
+
 func testAccNodeGroupConfig_releaseVersion(rName string, version string) string {
 	return acctest.ConfigCompose(testAccNodeGroupBaseVersionConfig(rName, version), fmt.Sprintf(`
 data "aws_ssm_parameter" "test" {
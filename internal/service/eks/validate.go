@@ -4,9 +4,11 @@
 package eks
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -26,3 +28,25 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validAddonConfigurationValues validates JSON add-on configuration values against the JSON schema
+// returned by the DescribeAddonConfiguration API.
+func validAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewStringLoader(configurationValues))
+
+	if err != nil {
+		return err
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	var errs []error
+
+	for _, v := range result.Errors() {
+		errs = append(errs, errors.New(v.String()))
+	}
+
+	return errors.Join(errs...)
+}
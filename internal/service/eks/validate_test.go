@@ -67,3 +67,65 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidAddonConfigurationValues(t *testing.T) {
+	t.Parallel()
+
+	configurationSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "replicaCount": {
+      "type": "integer",
+      "minimum": 1
+    },
+    "resources": {
+      "type": "object",
+      "properties": {
+        "limits": {
+          "type": "object"
+        }
+      }
+    }
+  }
+}`
+
+	cases := []struct {
+		Value         string
+		ExpectedError bool
+	}{
+		{
+			Value:         `{}`,
+			ExpectedError: false,
+		},
+		{
+			Value:         `{"replicaCount": 2}`,
+			ExpectedError: false,
+		},
+		{
+			Value:         `{"replicaCount": 0}`,
+			ExpectedError: true,
+		},
+		{
+			Value:         `{"replicaCount": "two"}`,
+			ExpectedError: true,
+		},
+		{
+			Value:         `{"replicaCont": 2}`,
+			ExpectedError: true,
+		},
+		{
+			Value:         `{"resources": {"limits": {"cpu": "100m"}}}`,
+			ExpectedError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validAddonConfigurationValues(configurationSchema, tc.Value)
+
+		if got, want := err != nil, tc.ExpectedError; got != want {
+			t.Errorf("validAddonConfigurationValues(%s) error = %v, expected error: %t", tc.Value, err, want)
+		}
+	}
+}
@@ -10,9 +10,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/schemas"
+	schemastypes "github.com/aws/aws-sdk-go-v2/service/schemas/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -40,18 +43,42 @@ func resourceBus() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dead_letter_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"event_source_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validSourceName,
 			},
+			"kms_key_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validCustomEventBusName,
 			},
+			"schema_discovery_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -70,10 +97,18 @@ func resourceBusCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		Tags: getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeadLetterConfig = expandDeadLetterParametersConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("event_source_name"); ok {
 		input.EventSourceName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("kms_key_identifier"); ok {
+		input.KmsKeyIdentifier = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateEventBus(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -103,6 +138,12 @@ func resourceBusCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.Get("schema_discovery_enabled").(bool) {
+		if err := createBusSchemasDiscoverer(ctx, meta.(*conns.AWSClient).SchemasClient(ctx), aws.ToString(output.EventBusArn)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "enabling EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceBusRead(ctx, d, meta)...)
 }
 
@@ -123,15 +164,72 @@ func resourceBusRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 
 	d.Set(names.AttrARN, output.Arn)
+	if output.DeadLetterConfig != nil {
+		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(output.DeadLetterConfig)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting dead_letter_config: %s", err)
+		}
+	} else {
+		d.Set("dead_letter_config", nil)
+	}
+	d.Set("kms_key_identifier", output.KmsKeyIdentifier)
 	d.Set(names.AttrName, output.Name)
 
+	// Only check for drift when schema discovery is managed by this resource, so that discoverers
+	// managed with aws_schemas_discoverer are left alone and no additional permissions are needed otherwise.
+	if d.Get("schema_discovery_enabled").(bool) {
+		discoverer, err := findSchemasDiscovererBySourceARN(ctx, meta.(*conns.AWSClient).SchemasClient(ctx), aws.ToString(output.Arn))
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("schema_discovery_enabled", false)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+		default:
+			d.Set("schema_discovery_enabled", discoverer.State == schemastypes.DiscovererStateStarted)
+		}
+	}
+
 	return diags
 }
 
 func resourceBusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
 
-	// Tags only.
+	if d.HasChanges("dead_letter_config", "kms_key_identifier") {
+		input := &eventbridge.UpdateEventBusInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DeadLetterConfig = expandDeadLetterParametersConfig(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("kms_key_identifier"); ok {
+			input.KmsKeyIdentifier = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateEventBus(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EventBridge Event Bus (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("schema_discovery_enabled") {
+		schemasConn := meta.(*conns.AWSClient).SchemasClient(ctx)
+		arn := d.Get(names.AttrARN).(string)
+
+		if d.Get("schema_discovery_enabled").(bool) {
+			if err := createBusSchemasDiscoverer(ctx, schemasConn, arn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+			}
+		} else {
+			if err := deleteBusSchemasDiscoverer(ctx, schemasConn, arn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "disabling EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+			}
+		}
+	}
 
 	return append(diags, resourceBusRead(ctx, d, meta)...)
 }
@@ -140,6 +238,12 @@ func resourceBusDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EventsClient(ctx)
 
+	if d.Get("schema_discovery_enabled").(bool) {
+		if err := deleteBusSchemasDiscoverer(ctx, meta.(*conns.AWSClient).SchemasClient(ctx), d.Get(names.AttrARN).(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling EventBridge Event Bus (%s) schema discovery: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting EventBridge Event Bus: %s", d.Id())
 	_, err := conn.DeleteEventBus(ctx, &eventbridge.DeleteEventBusInput{
 		Name: aws.String(d.Id()),
@@ -180,3 +284,77 @@ func findEventBusByName(ctx context.Context, conn *eventbridge.Client, name stri
 
 	return output, nil
 }
+
+// createBusSchemasDiscoverer creates an EventBridge Schemas discoverer for the event bus, or starts the existing one.
+func createBusSchemasDiscoverer(ctx context.Context, conn *schemas.Client, busARN string) error {
+	discoverer, err := findSchemasDiscovererBySourceARN(ctx, conn, busARN)
+
+	if tfresource.NotFound(err) {
+		_, err := conn.CreateDiscoverer(ctx, &schemas.CreateDiscovererInput{
+			SourceArn: aws.String(busARN),
+		})
+
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if discoverer.State == schemastypes.DiscovererStateStarted {
+		return nil
+	}
+
+	_, err = conn.StartDiscoverer(ctx, &schemas.StartDiscovererInput{
+		DiscovererId: discoverer.DiscovererId,
+	})
+
+	return err
+}
+
+func deleteBusSchemasDiscoverer(ctx context.Context, conn *schemas.Client, busARN string) error {
+	discoverer, err := findSchemasDiscovererBySourceARN(ctx, conn, busARN)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteDiscoverer(ctx, &schemas.DeleteDiscovererInput{
+		DiscovererId: discoverer.DiscovererId,
+	})
+
+	if errs.IsA[*schemastypes.NotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findSchemasDiscovererBySourceARN(ctx context.Context, conn *schemas.Client, arn string) (*schemastypes.DiscovererSummary, error) {
+	input := &schemas.ListDiscoverersInput{
+		SourceArnPrefix: aws.String(arn),
+	}
+
+	pages := schemas.NewListDiscoverersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Discoverers {
+			if aws.ToString(v.SourceArn) == arn {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -44,7 +44,7 @@ func ResourceKxCluster() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(4 * time.Hour),
 			Update: schema.DefaultTimeout(4 * time.Hour),
-			Delete: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Hour),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -724,6 +724,8 @@ func waitKxClusterCreated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
@@ -742,6 +744,8 @@ func waitKxClusterUpdated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
@@ -758,6 +762,8 @@ func waitKxClusterDeleted(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxClusterOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
@@ -254,6 +254,8 @@ func waitKxScalingGroupCreated(ctx context.Context, conn *finspace.Client, id st
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxScalingGroupOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
@@ -270,6 +272,8 @@ func waitKxScalingGroupDeleted(ctx context.Context, conn *finspace.Client, id st
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxScalingGroupOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
@@ -340,6 +340,8 @@ func waitKxVolumeCreated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
@@ -358,6 +360,8 @@ func waitKxVolumeUpdated(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
@@ -374,6 +378,8 @@ func waitKxVolumeDeleted(ctx context.Context, conn *finspace.Client, id string,
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusReason)))
+
 		return out, err
 	}
 
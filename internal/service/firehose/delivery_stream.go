@@ -38,6 +38,7 @@ const (
 	destinationTypeElasticsearch        destinationType = "elasticsearch"
 	destinationTypeExtendedS3           destinationType = "extended_s3"
 	destinationTypeHTTPEndpoint         destinationType = "http_endpoint"
+	destinationTypeIceberg              destinationType = "iceberg"
 	destinationTypeOpenSearch           destinationType = "opensearch"
 	destinationTypeOpenSearchServerless destinationType = "opensearchserverless"
 	destinationTypeRedshift             destinationType = "redshift"
@@ -50,6 +51,7 @@ func (destinationType) Values() []destinationType {
 		destinationTypeElasticsearch,
 		destinationTypeExtendedS3,
 		destinationTypeHTTPEndpoint,
+		destinationTypeIceberg,
 		destinationTypeOpenSearch,
 		destinationTypeOpenSearchServerless,
 		destinationTypeRedshift,
@@ -821,6 +823,78 @@ func resourceDeliveryStream() *schema.Resource {
 						},
 					},
 				},
+				"iceberg_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"buffering_interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      300,
+								ValidateFunc: validation.IntBetween(0, 900),
+							},
+							"buffering_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      5,
+								ValidateFunc: validation.IntBetween(1, 128),
+							},
+							"catalog_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
+							"destination_table_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrDatabaseName: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"s3_error_output_prefix": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+										names.AttrTableName: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"unique_keys": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"processing_configuration": processingConfigurationSchema(),
+							"retry_duration": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      300,
+								ValidateFunc: validation.IntBetween(0, 7200),
+							},
+							names.AttrRoleARN: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"s3_backup_mode": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          types.IcebergS3BackupModeFailedDataOnly,
+								ValidateDiagFunc: enum.Validate[types.IcebergS3BackupMode](),
+							},
+							"s3_configuration": s3ConfigurationSchema(),
+						},
+					},
+				},
 				"kinesis_source_configuration": {
 					Type:          schema.TypeList,
 					ForceNew:      true,
@@ -1364,6 +1438,7 @@ func resourceDeliveryStream() *schema.Resource {
 					destinationTypeElasticsearch:        "elasticsearch_configuration",
 					destinationTypeExtendedS3:           "extended_s3_configuration",
 					destinationTypeHTTPEndpoint:         "http_endpoint_configuration",
+					destinationTypeIceberg:              "iceberg_configuration",
 					destinationTypeOpenSearch:           "opensearch_configuration",
 					destinationTypeOpenSearchServerless: "opensearchserverless_configuration",
 					destinationTypeRedshift:             "redshift_configuration",
@@ -1413,6 +1488,10 @@ func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, m
 		if v, ok := d.GetOk("http_endpoint_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.HttpEndpointDestinationConfiguration = expandHTTPEndpointDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
 		}
+	case destinationTypeIceberg:
+		if v, ok := d.GetOk("iceberg_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.IcebergDestinationConfiguration = expandIcebergDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
 	case destinationTypeOpenSearch:
 		if v, ok := d.GetOk("opensearch_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.AmazonopensearchserviceDestinationConfiguration = expandAmazonopensearchserviceDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
@@ -1534,6 +1613,11 @@ func resourceDeliveryStreamRead(ctx context.Context, d *schema.ResourceData, met
 			if err := d.Set("http_endpoint_configuration", flattenHTTPEndpointDestinationDescription(destination.HttpEndpointDestinationDescription, configuredAccessKey)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting http_endpoint_configuration: %s", err)
 			}
+		case destination.IcebergDestinationDescription != nil:
+			d.Set(names.AttrDestination, destinationTypeIceberg)
+			if err := d.Set("iceberg_configuration", flattenIcebergDestinationDescription(destination.IcebergDestinationDescription)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting iceberg_configuration: %s", err)
+			}
 		case destination.AmazonopensearchserviceDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeOpenSearch)
 			if err := d.Set("opensearch_configuration", flattenAmazonopensearchserviceDestinationDescription(destination.AmazonopensearchserviceDestinationDescription)); err != nil {
@@ -1600,6 +1684,10 @@ func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, m
 			if v, ok := d.GetOk("http_endpoint_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.HttpEndpointDestinationUpdate = expandHTTPEndpointDestinationUpdate(v.([]interface{})[0].(map[string]interface{}))
 			}
+		case destinationTypeIceberg:
+			if v, ok := d.GetOk("iceberg_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.IcebergDestinationUpdate = expandIcebergDestinationUpdate(v.([]interface{})[0].(map[string]interface{}))
+			}
 		case destinationTypeOpenSearch:
 			if v, ok := d.GetOk("opensearch_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.AmazonopensearchserviceDestinationUpdate = expandAmazonopensearchserviceDestinationUpdate(v.([]interface{})[0].(map[string]interface{}))
@@ -2848,6 +2936,112 @@ func expandSnowflakeDestinationUpdate(tfMap map[string]interface{}) *types.Snowf
 	return apiObject
 }
 
+func expandIcebergDestinationConfiguration(tfMap map[string]interface{}) *types.IcebergDestinationConfiguration {
+	roleARN := tfMap[names.AttrRoleARN].(string)
+	apiObject := &types.IcebergDestinationConfiguration{
+		CatalogConfiguration: &types.CatalogConfiguration{
+			CatalogARN: aws.String(tfMap["catalog_arn"].(string)),
+		},
+		RetryOptions:    expandIcebergRetryOptions(tfMap),
+		RoleARN:         aws.String(roleARN),
+		S3Configuration: expandS3DestinationConfiguration(tfMap["s3_configuration"].([]interface{})),
+	}
+
+	bufferingHints := &types.BufferingHints{}
+	if bufferingInterval, ok := tfMap["buffering_interval"].(int); ok {
+		bufferingHints.IntervalInSeconds = aws.Int32(int32(bufferingInterval))
+	}
+	if bufferingSize, ok := tfMap["buffering_size"].(int); ok {
+		bufferingHints.SizeInMBs = aws.Int32(int32(bufferingSize))
+	}
+	apiObject.BufferingHints = bufferingHints
+
+	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
+		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
+	}
+
+	if v, ok := tfMap["destination_table_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DestinationTableConfigurationList = expandDestinationTableConfigurations(v)
+	}
+
+	if _, ok := tfMap["processing_configuration"]; ok {
+		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeIceberg, roleARN)
+	}
+
+	if v, ok := tfMap["s3_backup_mode"]; ok {
+		apiObject.S3BackupMode = types.IcebergS3BackupMode(v.(string))
+	}
+
+	return apiObject
+}
+
+func expandIcebergDestinationUpdate(tfMap map[string]interface{}) *types.IcebergDestinationUpdate {
+	roleARN := tfMap[names.AttrRoleARN].(string)
+	apiObject := &types.IcebergDestinationUpdate{
+		CatalogConfiguration: &types.CatalogConfiguration{
+			CatalogARN: aws.String(tfMap["catalog_arn"].(string)),
+		},
+		RetryOptions:    expandIcebergRetryOptions(tfMap),
+		RoleARN:         aws.String(roleARN),
+		S3Configuration: expandS3DestinationConfiguration(tfMap["s3_configuration"].([]interface{})),
+	}
+
+	bufferingHints := &types.BufferingHints{}
+	if bufferingInterval, ok := tfMap["buffering_interval"].(int); ok {
+		bufferingHints.IntervalInSeconds = aws.Int32(int32(bufferingInterval))
+	}
+	if bufferingSize, ok := tfMap["buffering_size"].(int); ok {
+		bufferingHints.SizeInMBs = aws.Int32(int32(bufferingSize))
+	}
+	apiObject.BufferingHints = bufferingHints
+
+	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
+		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
+	}
+
+	if v, ok := tfMap["destination_table_configuration"].([]interface{}); ok {
+		apiObject.DestinationTableConfigurationList = expandDestinationTableConfigurations(v)
+	}
+
+	if _, ok := tfMap["processing_configuration"]; ok {
+		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeIceberg, roleARN)
+	}
+
+	if v, ok := tfMap["s3_backup_mode"]; ok {
+		apiObject.S3BackupMode = types.IcebergS3BackupMode(v.(string))
+	}
+
+	return apiObject
+}
+
+func expandDestinationTableConfigurations(tfList []interface{}) []types.DestinationTableConfiguration {
+	apiObjects := make([]types.DestinationTableConfiguration, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DestinationTableConfiguration{
+			DestinationDatabaseName: aws.String(tfMap[names.AttrDatabaseName].(string)),
+			DestinationTableName:    aws.String(tfMap[names.AttrTableName].(string)),
+		}
+
+		if v, ok := tfMap["s3_error_output_prefix"].(string); ok && v != "" {
+			apiObject.S3ErrorOutputPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["unique_keys"].([]interface{}); ok && len(v) > 0 {
+			apiObject.UniqueKeys = flex.ExpandStringValueList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandSplunkDestinationConfiguration(tfMap map[string]interface{}) *types.SplunkDestinationConfiguration {
 	apiObject := &types.SplunkDestinationConfiguration{
 		HECAcknowledgmentTimeoutInSeconds: aws.Int32(int32(tfMap["hec_acknowledgment_timeout"].(int))),
@@ -3153,6 +3347,16 @@ func expandRedshiftRetryOptions(redshift map[string]interface{}) *types.Redshift
 	return retryOptions
 }
 
+func expandIcebergRetryOptions(tfMap map[string]interface{}) *types.RetryOptions {
+	apiObject := &types.RetryOptions{}
+
+	if v, ok := tfMap["retry_duration"].(int); ok {
+		apiObject.DurationInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
 func expandSnowflakeRetryOptions(tfMap map[string]interface{}) *types.SnowflakeRetryOptions {
 	apiObject := &types.SnowflakeRetryOptions{}
 
@@ -3563,6 +3767,54 @@ func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinatio
 	return []interface{}{tfMap}
 }
 
+func flattenIcebergDestinationDescription(apiObject *types.IcebergDestinationDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	roleARN := aws.ToString(apiObject.RoleARN)
+	tfMap := map[string]interface{}{
+		"cloudwatch_logging_options":      flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions),
+		"destination_table_configuration": flattenDestinationTableConfigurations(apiObject.DestinationTableConfigurationList),
+		"processing_configuration":        flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeIceberg, roleARN),
+		names.AttrRoleARN:                 roleARN,
+		"s3_backup_mode":                  apiObject.S3BackupMode,
+		"s3_configuration":                flattenS3DestinationDescription(apiObject.S3DestinationDescription),
+	}
+
+	if apiObject.BufferingHints != nil {
+		tfMap["buffering_interval"] = int(aws.ToInt32(apiObject.BufferingHints.IntervalInSeconds))
+		tfMap["buffering_size"] = int(aws.ToInt32(apiObject.BufferingHints.SizeInMBs))
+	}
+
+	if apiObject.CatalogConfiguration != nil {
+		tfMap["catalog_arn"] = aws.ToString(apiObject.CatalogConfiguration.CatalogARN)
+	}
+
+	if apiObject.RetryOptions != nil {
+		tfMap["retry_duration"] = int(aws.ToInt32(apiObject.RetryOptions.DurationInSeconds))
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDestinationTableConfigurations(apiObjects []types.DestinationTableConfiguration) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrDatabaseName:   aws.ToString(apiObject.DestinationDatabaseName),
+			"s3_error_output_prefix": aws.ToString(apiObject.S3ErrorOutputPrefix),
+			names.AttrTableName:      aws.ToString(apiObject.DestinationTableName),
+			"unique_keys":            apiObject.UniqueKeys,
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenSplunkDestinationDescription(apiObject *types.SplunkDestinationDescription) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
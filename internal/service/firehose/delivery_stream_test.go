@@ -1315,6 +1315,64 @@ func TestAccFirehoseDeliveryStream_snowflakeUpdates(t *testing.T) {
 	})
 }
 
+func TestAccFirehoseDeliveryStream_icebergUpdates(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stream types.DeliveryStreamDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FirehoseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryStreamConfig_icebergBasic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "iceberg"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_id"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_interval", "300"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_size", "5"),
+					resource.TestCheckResourceAttrSet(resourceName, "iceberg_configuration.0.catalog_arn"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.destination_table_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.processing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.processing_configuration.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.retry_duration", "300"),
+					resource.TestCheckResourceAttrPair(resourceName, "iceberg_configuration.0.role_arn", "aws_iam_role.firehose", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.s3_backup_mode", "FailedDataOnly"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.s3_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "iceberg_configuration.0.s3_configuration.0.bucket_arn", "aws_s3_bucket.bucket", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeliveryStreamConfig_icebergUpdate(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_interval", "600"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.buffering_size", "64"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.destination_table_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "iceberg_configuration.0.destination_table_configuration.0.database_name", "aws_glue_catalog_database.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.destination_table_configuration.0.s3_error_output_prefix", "error"),
+					resource.TestCheckResourceAttrPair(resourceName, "iceberg_configuration.0.destination_table_configuration.0.table_name", "aws_glue_catalog_table.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.destination_table_configuration.0.unique_keys.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.destination_table_configuration.0.unique_keys.0", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "iceberg_configuration.0.retry_duration", "900"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFirehoseDeliveryStream_splunkUpdates(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stream types.DeliveryStreamDescription
@@ -3961,6 +4019,125 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName))
 }
 
+func testAccDeliveryStreamConfig_baseIceberg(rName string) string {
+	return acctest.ConfigCompose(testAccDeliveryStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "iceberg" {
+  bucket        = "%[1]s-iceberg"
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  open_table_format_input {
+    iceberg_input {
+      metadata_operation = "CREATE"
+      version            = 2
+    }
+  }
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.iceberg.bucket}/files/"
+
+    columns {
+      name = "my_column_1"
+      type = "int"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_iam_role_policy" "iceberg" {
+  name = "%[1]s-iceberg"
+  role = aws_iam_role.firehose.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["glue:GetDatabase", "glue:GetTable", "glue:UpdateTable"]
+        Effect   = "Allow"
+        Resource = "*"
+      },
+      {
+        Action = [
+          "s3:AbortMultipartUpload",
+          "s3:DeleteObject",
+          "s3:GetBucketLocation",
+          "s3:GetObject",
+          "s3:ListBucket",
+          "s3:ListBucketMultipartUploads",
+          "s3:PutObject",
+        ]
+        Effect   = "Allow"
+        Resource = [aws_s3_bucket.iceberg.arn, "${aws_s3_bucket.iceberg.arn}/*"]
+      },
+    ]
+  })
+}
+`, rName))
+}
+
+func testAccDeliveryStreamConfig_icebergBasic(rName string) string {
+	return acctest.ConfigCompose(testAccDeliveryStreamConfig_baseIceberg(rName), fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose, aws_iam_role_policy.iceberg]
+  name        = %[1]q
+  destination = "iceberg"
+
+  iceberg_configuration {
+    catalog_arn = "arn:${data.aws_partition.current.partition}:glue:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:catalog"
+    role_arn    = aws_iam_role.firehose.arn
+
+    s3_configuration {
+      bucket_arn = aws_s3_bucket.bucket.arn
+      role_arn   = aws_iam_role.firehose.arn
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDeliveryStreamConfig_icebergUpdate(rName string) string {
+	return acctest.ConfigCompose(testAccDeliveryStreamConfig_baseIceberg(rName), fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose, aws_iam_role_policy.iceberg]
+  name        = %[1]q
+  destination = "iceberg"
+
+  iceberg_configuration {
+    buffering_interval = 600
+    buffering_size     = 64
+    catalog_arn        = "arn:${data.aws_partition.current.partition}:glue:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:catalog"
+    retry_duration     = 900
+    role_arn           = aws_iam_role.firehose.arn
+
+    destination_table_configuration {
+      database_name          = aws_glue_catalog_database.test.name
+      s3_error_output_prefix = "error"
+      table_name             = aws_glue_catalog_table.test.name
+      unique_keys            = ["my_column_1"]
+    }
+
+    s3_configuration {
+      bucket_arn = aws_s3_bucket.bucket.arn
+      role_arn   = aws_iam_role.firehose.arn
+    }
+  }
+}
+`, rName))
+}
+
 func testAccDeliveryStreamConfig_snowflakeBasic(rName, privateKey string) string {
 	return acctest.ConfigCompose(testAccDeliveryStreamConfig_base(rName), fmt.Sprintf(`
 resource "aws_kinesis_firehose_delivery_stream" "test" {
@@ -5,6 +5,8 @@ package fms
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/fms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/fms/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -41,7 +44,10 @@ func resourcePolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			validateManagedServiceDataType,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -175,6 +181,42 @@ func resourcePolicy() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"network_acl_common_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"network_acl_entry_set": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"first_entry": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																Elem:     networkACLEntrySchema(),
+															},
+															"force_remediate_for_first_entries": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+															"force_remediate_for_last_entries": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+															"last_entry": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																Elem:     networkACLEntrySchema(),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"network_firewall_policy": {
 										Type:     schema.TypeList,
 										Optional: true,
@@ -219,6 +261,102 @@ func resourcePolicy() *schema.Resource {
 	}
 }
 
+func networkACLEntrySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrCIDRBlock: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			},
+			"egress": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"icmp_type_code": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ipv6_cidr_block": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+			},
+			"port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumberOrZero,
+						},
+						"to": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumberOrZero,
+						},
+					},
+				},
+			},
+			names.AttrProtocol: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule_action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.NetworkAclRuleAction](),
+			},
+		},
+	}
+}
+
+// validateManagedServiceDataType verifies that the "type" in managed_service_data matches the policy type.
+func validateManagedServiceDataType(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("security_service_policy_data")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	policyType := tfMap[names.AttrType].(string)
+	managedServiceData := tfMap["managed_service_data"].(string)
+
+	if policyType == "" || managedServiceData == "" {
+		return nil
+	}
+
+	var data struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal([]byte(managedServiceData), &data); err != nil {
+		return fmt.Errorf("decoding security_service_policy_data.managed_service_data: %w", err)
+	}
+
+	if data.Type != "" && data.Type != policyType {
+		return fmt.Errorf("security_service_policy_data.managed_service_data type (%s) does not match security_service_policy_data.type (%s)", data.Type, policyType)
+	}
+
+	return nil
+}
+
 func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FMSClient(ctx)
@@ -422,6 +560,10 @@ func expandPolicyOption(tfMap map[string]interface{}) *awstypes.PolicyOption {
 
 	apiObject := &awstypes.PolicyOption{}
 
+	if v, ok := tfMap["network_acl_common_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkAclCommonPolicy = expandPolicyOptionNetworkACLCommon(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["network_firewall_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		apiObject.NetworkFirewallPolicy = expandPolicyOptionNetworkFirewall(v[0].(map[string]interface{}))
 	}
@@ -433,6 +575,86 @@ func expandPolicyOption(tfMap map[string]interface{}) *awstypes.PolicyOption {
 	return apiObject
 }
 
+func expandPolicyOptionNetworkACLCommon(tfMap map[string]interface{}) *awstypes.NetworkAclCommonPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.NetworkAclCommonPolicy{}
+
+	if v, ok := tfMap["network_acl_entry_set"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkAclEntrySet = expandNetworkACLEntrySet(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandNetworkACLEntrySet(tfMap map[string]interface{}) *awstypes.NetworkAclEntrySet {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.NetworkAclEntrySet{
+		ForceRemediateForFirstEntries: aws.Bool(tfMap["force_remediate_for_first_entries"].(bool)),
+		ForceRemediateForLastEntries:  aws.Bool(tfMap["force_remediate_for_last_entries"].(bool)),
+	}
+
+	if v, ok := tfMap["first_entry"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FirstEntries = expandNetworkACLEntries(v.List())
+	}
+
+	if v, ok := tfMap["last_entry"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LastEntries = expandNetworkACLEntries(v.List())
+	}
+
+	return apiObject
+}
+
+func expandNetworkACLEntries(tfList []interface{}) []awstypes.NetworkAclEntry {
+	var apiObjects []awstypes.NetworkAclEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.NetworkAclEntry{
+			Egress:     aws.Bool(tfMap["egress"].(bool)),
+			Protocol:   aws.String(tfMap[names.AttrProtocol].(string)),
+			RuleAction: awstypes.NetworkAclRuleAction(tfMap["rule_action"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrCIDRBlock].(string); ok && v != "" {
+			apiObject.CidrBlock = aws.String(v)
+		}
+
+		if v, ok := tfMap["icmp_type_code"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.IcmpTypeCode = &awstypes.NetworkAclIcmpTypeCode{
+				Code: aws.Int32(int32(tfMap["code"].(int))),
+				Type: aws.Int32(int32(tfMap[names.AttrType].(int))),
+			}
+		}
+
+		if v, ok := tfMap["ipv6_cidr_block"].(string); ok && v != "" {
+			apiObject.Ipv6CidrBlock = aws.String(v)
+		}
+
+		if v, ok := tfMap["port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.PortRange = &awstypes.NetworkAclPortRange{
+				From: aws.Int32(int32(tfMap["from"].(int))),
+				To:   aws.Int32(int32(tfMap["to"].(int))),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandPolicyOptionNetworkFirewall(tfMap map[string]interface{}) *awstypes.NetworkFirewallPolicy {
 	if tfMap == nil {
 		return nil
@@ -508,6 +730,10 @@ func flattenPolicyOption(fmsPolicyOption *awstypes.PolicyOption) []interface{} {
 
 	tfMap := map[string]interface{}{}
 
+	if v := fmsPolicyOption.NetworkAclCommonPolicy; v != nil {
+		tfMap["network_acl_common_policy"] = flattenPolicyOptionNetworkACLCommon(v)
+	}
+
 	if v := fmsPolicyOption.NetworkFirewallPolicy; v != nil {
 		tfMap["network_firewall_policy"] = flattenPolicyOptionNetworkFirewall(fmsPolicyOption.NetworkFirewallPolicy)
 	}
@@ -519,6 +745,57 @@ func flattenPolicyOption(fmsPolicyOption *awstypes.PolicyOption) []interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenPolicyOptionNetworkACLCommon(apiObject *awstypes.NetworkAclCommonPolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NetworkAclEntrySet; v != nil {
+		tfMap["network_acl_entry_set"] = []interface{}{map[string]interface{}{
+			"first_entry":                       flattenNetworkACLEntries(v.FirstEntries),
+			"force_remediate_for_first_entries": aws.ToBool(v.ForceRemediateForFirstEntries),
+			"force_remediate_for_last_entries":  aws.ToBool(v.ForceRemediateForLastEntries),
+			"last_entry":                        flattenNetworkACLEntries(v.LastEntries),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNetworkACLEntries(apiObjects []awstypes.NetworkAclEntry) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrCIDRBlock: aws.ToString(apiObject.CidrBlock),
+			"egress":            aws.ToBool(apiObject.Egress),
+			"ipv6_cidr_block":   aws.ToString(apiObject.Ipv6CidrBlock),
+			names.AttrProtocol:  aws.ToString(apiObject.Protocol),
+			"rule_action":       string(apiObject.RuleAction),
+		}
+
+		if v := apiObject.IcmpTypeCode; v != nil {
+			tfMap["icmp_type_code"] = []interface{}{map[string]interface{}{
+				"code":         aws.ToInt32(v.Code),
+				names.AttrType: aws.ToInt32(v.Type),
+			}}
+		}
+
+		if v := apiObject.PortRange; v != nil {
+			tfMap["port_range"] = []interface{}{map[string]interface{}{
+				"from": aws.ToInt32(v.From),
+				"to":   aws.ToInt32(v.To),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenPolicyOptionNetworkFirewall(fmsNetworkFirewallPolicy *awstypes.NetworkFirewallPolicy) []interface{} {
 	if fmsNetworkFirewallPolicy == nil {
 		return nil
@@ -227,6 +227,75 @@ func testAccPolicy_policyOption(t *testing.T) {
 	})
 }
 
+func testAccPolicy_networkACL(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_networkACL(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "NETWORK_ACL_COMMON"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.*", map[string]string{
+						names.AttrCIDRBlock: "10.0.0.0/8",
+						"egress":            acctest.CtFalse,
+						"port_range.#":      acctest.Ct1,
+						"port_range.0.from": "443",
+						"port_range.0.to":   "443",
+						names.AttrProtocol:  "6",
+						"rule_action":       "allow",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.force_remediate_for_first_entries", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.force_remediate_for_last_entries", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.last_entry.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
+func testAccPolicy_managedServiceDataTypeMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_managedServiceDataTypeMismatch(rName),
+				ExpectError: regexache.MustCompile(`managed_service_data type \(WAFV2\) does not match`),
+			},
+		},
+	})
+}
+
 func testAccPolicy_resourceTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -508,6 +577,71 @@ resource "aws_wafregional_rule_group" "test" {
 `, policyName, ruleGroupName))
 }
 
+func testAccPolicyConfig_networkACL(rName string) string {
+	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::Subnet"
+
+  exclude_map {
+    account = [data.aws_caller_identity.current.account_id]
+  }
+
+  security_service_policy_data {
+    type = "NETWORK_ACL_COMMON"
+
+    policy_option {
+      network_acl_common_policy {
+        network_acl_entry_set {
+          force_remediate_for_first_entries = true
+          force_remediate_for_last_entries  = false
+
+          first_entry {
+            cidr_block  = "10.0.0.0/8"
+            egress      = false
+            protocol    = "6"
+            rule_action = "allow"
+
+            port_range {
+              from = 443
+              to   = 443
+            }
+          }
+
+          last_entry {
+            cidr_block  = "0.0.0.0/0"
+            egress      = true
+            protocol    = "-1"
+            rule_action = "deny"
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName))
+}
+
+func testAccPolicyConfig_managedServiceDataTypeMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAFV2\"}"
+  }
+}
+`, rName)
+}
+
 func testAccPolicyConfig_cloudFrontDistribution(rName string) string {
 	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
 data "aws_partition" "current" {}
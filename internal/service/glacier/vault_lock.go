@@ -5,6 +5,7 @@ package glacier
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -29,24 +30,46 @@ func resourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultLockCreate,
 		ReadWithoutTimeout:   resourceVaultLockRead,
-		UpdateWithoutTimeout: schema.NoopContext, // Allow ignore_deletion_error update.
+		UpdateWithoutTimeout: resourceVaultLockUpdate,
 		DeleteWithoutTimeout: resourceVaultLockDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceVaultLockCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			"abort_window": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiration_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true,
 			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"lock_id": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			names.AttrPolicy: {
 				Type:                  schema.TypeString,
 				Required:              true,
@@ -85,37 +108,19 @@ func resourceVaultLockCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	vaultName := d.Get("vault_name").(string)
-	input := &glacier.InitiateVaultLockInput{
-		AccountId: aws.String("-"),
-		Policy: &types.VaultLockPolicy{
-			Policy: aws.String(policy),
-		},
-		VaultName: aws.String(vaultName),
-	}
-
-	output, err := conn.InitiateVaultLock(ctx, input)
+	lockID, err := initiateVaultLock(ctx, conn, vaultName, policy)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Glacier Vault Lock (%s): %s", vaultName, err)
 	}
 
 	d.SetId(vaultName)
+	d.Set("lock_id", lockID)
 
 	if d.Get("complete_lock").(bool) {
-		input := &glacier.CompleteVaultLockInput{
-			LockId:    output.LockId,
-			VaultName: aws.String(vaultName),
-		}
-
-		_, err := conn.CompleteVaultLock(ctx, input)
-
-		if err != nil {
+		if err := completeVaultLock(ctx, conn, d.Id(), lockID); err != nil {
 			return sdkdiag.AppendErrorf(diags, "completing Glacier Vault Lock (%s): %s", d.Id(), err)
 		}
-
-		if err := waitVaultLockComplete(ctx, conn, d.Id()); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Glacier Vault Lock (%s) completion: %s", d.Id(), err)
-		}
 	}
 
 	return append(diags, resourceVaultLockRead(ctx, d, meta)...)
@@ -137,6 +142,17 @@ func resourceVaultLockRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading Glacier Vault Lock (%s): %s", d.Id(), err)
 	}
 
+	if aws.ToString(output.State) == lockStateInProgress {
+		if err := d.Set("abort_window", []interface{}{map[string]interface{}{
+			names.AttrCreationDate: aws.ToString(output.CreationDate),
+			"expiration_date":      aws.ToString(output.ExpirationDate),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting abort_window: %s", err)
+		}
+	} else {
+		d.Set("abort_window", nil)
+		d.Set("lock_id", nil)
+	}
 	d.Set("complete_lock", aws.ToString(output.State) == lockStateLocked)
 	d.Set("vault_name", d.Id())
 
@@ -151,6 +167,46 @@ func resourceVaultLockRead(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func resourceVaultLockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	if d.HasChange("complete_lock") && d.Get("complete_lock").(bool) {
+		lockID := d.Get("lock_id").(string)
+
+		// The lock ID is only returned by InitiateVaultLock, e.g. it is unknown for an imported in-progress lock.
+		// Restart the in-progress lock with the same policy to obtain a fresh lock ID.
+		if lockID == "" {
+			policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			_, err = conn.AbortVaultLock(ctx, &glacier.AbortVaultLockInput{
+				AccountId: aws.String("-"),
+				VaultName: aws.String(d.Id()),
+			})
+
+			if err != nil && !errs.IsA[*types.ResourceNotFoundException](err) {
+				return sdkdiag.AppendErrorf(diags, "aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+			}
+
+			lockID, err = initiateVaultLock(ctx, conn, d.Id(), policy)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "initiating Glacier Vault Lock (%s): %s", d.Id(), err)
+			}
+		}
+
+		if err := completeVaultLock(ctx, conn, d.Id(), lockID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "completing Glacier Vault Lock (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVaultLockRead(ctx, d, meta)...)
+}
+
 func resourceVaultLockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
@@ -171,6 +227,55 @@ func resourceVaultLockDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceVaultLockCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// An in-progress lock can be completed in place, but a completed lock can only be replaced.
+	if d.HasChange("complete_lock") && !d.Get("complete_lock").(bool) {
+		return d.ForceNew("complete_lock")
+	}
+
+	return nil
+}
+
+func initiateVaultLock(ctx context.Context, conn *glacier.Client, vaultName, policy string) (string, error) {
+	input := &glacier.InitiateVaultLockInput{
+		AccountId: aws.String("-"),
+		Policy: &types.VaultLockPolicy{
+			Policy: aws.String(policy),
+		},
+		VaultName: aws.String(vaultName),
+	}
+
+	output, err := conn.InitiateVaultLock(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.LockId), nil
+}
+
+func completeVaultLock(ctx context.Context, conn *glacier.Client, vaultName, lockID string) error {
+	input := &glacier.CompleteVaultLockInput{
+		AccountId: aws.String("-"),
+		LockId:    aws.String(lockID),
+		VaultName: aws.String(vaultName),
+	}
+
+	if _, err := conn.CompleteVaultLock(ctx, input); err != nil {
+		return err
+	}
+
+	if err := waitVaultLockComplete(ctx, conn, vaultName); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func findVaultLockByName(ctx context.Context, conn *glacier.Client, name string) (*glacier.GetVaultLockOutput, error) {
 	input := &glacier.GetVaultLockInput{
 		AccountId: aws.String("-"),
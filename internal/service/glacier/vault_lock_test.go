@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -36,8 +37,12 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				Config: testAccVaultLockConfig_complete(rName, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
+					resource.TestCheckResourceAttr(resourceName, "abort_window.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "abort_window.0.creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "abort_window.0.expiration_date"),
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_id"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", vaultResourceName, names.AttrName),
 				),
@@ -46,7 +51,7 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
 			},
 		},
 	})
@@ -79,7 +84,44 @@ func TestAccGlacierVaultLock_completeLock(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
+			},
+		},
+	})
+}
+
+func TestAccGlacierVaultLock_completeLockInPlace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vaultLock1, vaultLock2 glacier.GetVaultLockOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultLockDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockConfig_completeInPlace(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock1),
+					resource.TestCheckResourceAttr(resourceName, "abort_window.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccVaultLockConfig_completeInPlace(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(ctx, resourceName, &vaultLock2),
+					resource.TestCheckResourceAttr(resourceName, "abort_window.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", acctest.CtTrue),
+				),
 			},
 		},
 	})
@@ -205,6 +247,44 @@ resource "aws_glacier_vault_lock" "test" {
 `, rName, completeLock, completeLock)
 }
 
+func testAccVaultLockConfig_completeInPlace(rName string, completeLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_vault" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    # Allow for testing purposes
+    actions   = ["glacier:DeleteArchive"]
+    effect    = "Allow"
+    resources = [aws_glacier_vault.test.arn]
+
+    condition {
+      test     = "NumericLessThanEquals"
+      variable = "glacier:ArchiveAgeinDays"
+      values   = ["0"]
+    }
+
+    principals {
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+      type        = "AWS"
+    }
+  }
+}
+
+resource "aws_glacier_vault_lock" "test" {
+  complete_lock         = %[2]t
+  ignore_deletion_error = true
+  policy                = data.aws_iam_policy_document.test.json
+  vault_name            = aws_glacier_vault.test.name
+}
+`, rName, completeLock)
+}
+
 func testAccVaultLockConfig_policyOrder(rName string, completeLock bool) string {
 	return fmt.Sprintf(`
 resource "aws_glacier_vault" "test" {
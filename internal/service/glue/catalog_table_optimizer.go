@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_glue_catalog_table_optimizer")
+func ResourceCatalogTableOptimizer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCatalogTableOptimizerCreate,
+		ReadWithoutTimeout:   resourceCatalogTableOptimizerRead,
+		UpdateWithoutTimeout: resourceCatalogTableOptimizerUpdate,
+		DeleteWithoutTimeout: resourceCatalogTableOptimizerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCatalogID: {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrDatabaseName: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrTableName: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(glue.TableOptimizerType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceCatalogTableOptimizerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get(names.AttrDatabaseName).(string)
+	tableName := d.Get(names.AttrTableName).(string)
+	optimizerType := d.Get(names.AttrType).(string)
+	id := createCatalogTableOptimizerID(catalogID, dbName, tableName, optimizerType)
+
+	input := &glue.CreateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	// The optimizer's IAM role may not yet be assumable by Glue.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateTableOptimizerWithContext(ctx, input)
+	}, glue.ErrCodeAccessDeniedException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glue Catalog Table Optimizer (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID, dbName, tableName, optimizerType, err := readCatalogTableOptimizerID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	optimizer, err := FindCatalogTableOptimizer(ctx, conn, catalogID, dbName, tableName, optimizerType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Catalog Table Optimizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCatalogID, catalogID)
+	if err := d.Set(names.AttrConfiguration, flattenTableOptimizerConfiguration(optimizer.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set(names.AttrDatabaseName, dbName)
+	d.Set(names.AttrTableName, tableName)
+	d.Set(names.AttrType, optimizer.Type)
+
+	return diags
+}
+
+func resourceCatalogTableOptimizerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID, dbName, tableName, optimizerType, err := readCatalogTableOptimizerID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	input := &glue.UpdateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.UpdateTableOptimizerWithContext(ctx, input)
+	}, glue.ErrCodeAccessDeniedException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID, dbName, tableName, optimizerType, err := readCatalogTableOptimizerID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Table Optimizer: %s", d.Id())
+	_, err = conn.DeleteTableOptimizerWithContext(ctx, &glue.DeleteTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandTableOptimizerConfiguration(l []interface{}) *glue.TableOptimizerConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	apiObject := &glue.TableOptimizerConfiguration{}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTableOptimizerConfiguration(apiObject *glue.TableOptimizerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: aws.BoolValue(apiObject.Enabled),
+		names.AttrRoleARN: aws.StringValue(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -276,6 +276,34 @@ func FindPartitionIndexByName(ctx context.Context, conn *glue.Glue, id string) (
 	return result, nil
 }
 
+func FindCatalogTableOptimizer(ctx context.Context, conn *glue.Glue, catalogID, dbName, tableName, optimizerType string) (*glue.TableOptimizer, error) {
+	input := &glue.GetTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	}
+
+	output, err := conn.GetTableOptimizerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableOptimizer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TableOptimizer, nil
+}
+
 func FindClassifierByName(ctx context.Context, conn *glue.Glue, name string) (*glue.Classifier, error) {
 	input := &glue.GetClassifierInput{
 		Name: aws.String(name),
@@ -37,6 +37,18 @@ func createPartitionIndexID(catalogID, dbName, tableName, indexName string) stri
 	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, indexName)
 }
 
+func readCatalogTableOptimizerID(id string) (string, string, string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 4 {
+		return "", "", "", "", fmt.Errorf("expected ID in format catalog-id:database-name:table-name:type, received: %s", id)
+	}
+	return idParts[0], idParts[1], idParts[2], idParts[3], nil
+}
+
+func createCatalogTableOptimizerID(catalogID, dbName, tableName, optimizerType string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, optimizerType)
+}
+
 func stringifyPartition(partValues []interface{}) string {
 	var b bytes.Buffer
 	for _, val := range partValues {
@@ -59,6 +59,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCatalogTable,
 			TypeName: "aws_glue_catalog_table",
 		},
+		{
+			Factory:  ResourceCatalogTableOptimizer,
+			TypeName: "aws_glue_catalog_table_optimizer",
+		},
 		{
 			Factory:  ResourceClassifier,
 			TypeName: "aws_glue_classifier",
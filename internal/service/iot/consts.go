@@ -10,4 +10,6 @@ import (
 const (
 	propagationTimeout          = 2 * time.Minute
 	deprecatePropagationTimeout = 6 * time.Minute
+
+	topicRuleDestinationEnabledTimeout = 30 * time.Minute
 )
@@ -11,8 +11,11 @@ var (
 	ResourceCertificate              = resourceCertificate
 	ResourceDomainConfiguration      = resourceDomainConfiguration
 	ResourceEventConfigurations      = resourceEventConfigurations
+	ResourceFleetMetric              = resourceFleetMetric
 	ResourceIndexingConfiguration    = resourceIndexingConfiguration
 	ResourceLoggingOptions           = resourceLoggingOptions
+	ResourcePackage                  = resourcePackage
+	ResourcePackageVersion           = resourcePackageVersion
 	ResourcePolicy                   = resourcePolicy
 	ResourcePolicyAttachment         = resourcePolicyAttachment
 	ResourceProvisioningTemplate     = resourceProvisioningTemplate
@@ -30,6 +33,9 @@ var (
 	FindCACertificateByID                    = findCACertificateByID
 	FindCertificateByID                      = findCertificateByID
 	FindDomainConfigurationByName            = findDomainConfigurationByName
+	FindFleetMetricByName                    = findFleetMetricByName
+	FindPackageByName                        = findPackageByName
+	FindPackageVersionByTwoPartKey           = findPackageVersionByTwoPartKey
 	FindPolicyByName                         = findPolicyByName
 	FindPolicyVersionsByName                 = findPolicyVersionsByName
 	FindProvisioningTemplateByName           = findProvisioningTemplateByName
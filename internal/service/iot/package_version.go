@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iot/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iot_package_version", name="Package Version")
+// @Tags(identifierAttribute="arn")
+func resourcePackageVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageVersionCreate,
+		ReadWithoutTimeout:   resourcePackageVersionRead,
+		UpdateWithoutTimeout: resourcePackageVersionUpdate,
+		DeleteWithoutTimeout: resourcePackageVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrAttributes: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"error_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validPackageName,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PackageVersionStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_\-\.]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ForceNewIf(names.AttrStatus, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				// A package version can't be returned to draft once it has been published.
+				o, n := d.GetChange(names.AttrStatus)
+				return o.(string) != "" && o.(string) != string(awstypes.PackageVersionStatusDraft) && n.(string) == string(awstypes.PackageVersionStatusDraft)
+			}),
+		),
+	}
+}
+
+func resourcePackageVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTClient(ctx)
+
+	packageName := d.Get("package_name").(string)
+	versionName := d.Get("version_name").(string)
+	id := packageVersionCreateResourceID(packageName, versionName)
+	input := &iot.CreatePackageVersionInput{
+		PackageName: aws.String(packageName),
+		Tags:        KeyValueTags(ctx, getTagsIn(ctx)).Map(),
+		VersionName: aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk(names.AttrAttributes); ok && len(v.(map[string]interface{})) > 0 {
+		input.Attributes = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreatePackageVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Package Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	switch awstypes.PackageVersionStatus(d.Get(names.AttrStatus).(string)) {
+	case awstypes.PackageVersionStatusPublished:
+		if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, awstypes.PackageVersionActionPublish); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing IoT Package Version (%s): %s", d.Id(), err)
+		}
+	case awstypes.PackageVersionStatusDeprecated:
+		if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, awstypes.PackageVersionActionPublish); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing IoT Package Version (%s): %s", d.Id(), err)
+		}
+
+		if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, awstypes.PackageVersionActionDeprecate); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deprecating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("default_version").(bool) {
+		if err := setPackageDefaultVersion(ctx, conn, packageName, versionName); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting IoT Package Version (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourcePackageVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTClient(ctx)
+
+	packageName, versionName, err := packageVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findPackageVersionByTwoPartKey(ctx, conn, packageName, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Package Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	pkg, err := findPackageByName(ctx, conn, packageName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Package (%s): %s", packageName, err)
+	}
+
+	d.Set(names.AttrARN, output.PackageVersionArn)
+	d.Set(names.AttrAttributes, output.Attributes)
+	if output.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set("default_version", aws.ToString(pkg.DefaultVersionName) == versionName)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("error_reason", output.ErrorReason)
+	if output.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.ToTime(output.LastModifiedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_date", nil)
+	}
+	d.Set("package_name", output.PackageName)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("version_name", output.VersionName)
+
+	return diags
+}
+
+func resourcePackageVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTClient(ctx)
+
+	packageName, versionName, err := packageVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges(names.AttrAttributes, names.AttrDescription) {
+		input := &iot.UpdatePackageVersionInput{
+			Attributes:  flex.ExpandStringValueMap(d.Get(names.AttrAttributes).(map[string]interface{})),
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			PackageName: aws.String(packageName),
+			VersionName: aws.String(versionName),
+		}
+
+		_, err := conn.UpdatePackageVersion(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Package Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrStatus) {
+		var action awstypes.PackageVersionAction
+
+		switch awstypes.PackageVersionStatus(d.Get(names.AttrStatus).(string)) {
+		case awstypes.PackageVersionStatusPublished:
+			action = awstypes.PackageVersionActionPublish
+		case awstypes.PackageVersionStatusDeprecated:
+			// A draft package version must be published before it can be deprecated.
+			if o, _ := d.GetChange(names.AttrStatus); awstypes.PackageVersionStatus(o.(string)) == awstypes.PackageVersionStatusDraft {
+				if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, awstypes.PackageVersionActionPublish); err != nil {
+					return sdkdiag.AppendErrorf(diags, "publishing IoT Package Version (%s): %s", d.Id(), err)
+				}
+			}
+
+			action = awstypes.PackageVersionActionDeprecate
+		}
+
+		if action != "" {
+			if err := updatePackageVersionStatus(ctx, conn, packageName, versionName, action); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IoT Package Version (%s) status: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("default_version") {
+		if d.Get("default_version").(bool) {
+			if err := setPackageDefaultVersion(ctx, conn, packageName, versionName); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting IoT Package Version (%s) as default: %s", d.Id(), err)
+			}
+		} else {
+			if err := unsetPackageDefaultVersion(ctx, conn, packageName, versionName); err != nil {
+				return sdkdiag.AppendErrorf(diags, "unsetting IoT Package Version (%s) as default: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourcePackageVersionRead(ctx, d, meta)...)
+}
+
+func resourcePackageVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTClient(ctx)
+
+	packageName, versionName, err := packageVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.Get("default_version").(bool) {
+		if err := unsetPackageDefaultVersion(ctx, conn, packageName, versionName); err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "unsetting IoT Package Version (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Package Version: %s", d.Id())
+	_, err = conn.DeletePackageVersion(ctx, &iot.DeletePackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Package Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const packageVersionResourceIDSeparator = "/"
+
+func packageVersionCreateResourceID(packageName, versionName string) string {
+	parts := []string{packageName, versionName}
+	id := strings.Join(parts, packageVersionResourceIDSeparator)
+
+	return id
+}
+
+func packageVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, packageVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected package-name%[2]sversion-name", id, packageVersionResourceIDSeparator)
+}
+
+func updatePackageVersionStatus(ctx context.Context, conn *iot.Client, packageName, versionName string, action awstypes.PackageVersionAction) error {
+	input := &iot.UpdatePackageVersionInput{
+		Action:      action,
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	_, err := conn.UpdatePackageVersion(ctx, input)
+
+	return err
+}
+
+func setPackageDefaultVersion(ctx context.Context, conn *iot.Client, packageName, versionName string) error {
+	input := &iot.UpdatePackageInput{
+		DefaultVersionName: aws.String(versionName),
+		PackageName:        aws.String(packageName),
+	}
+
+	_, err := conn.UpdatePackage(ctx, input)
+
+	return err
+}
+
+// unsetPackageDefaultVersion clears the package's default version, but only if it is still the specified version.
+func unsetPackageDefaultVersion(ctx context.Context, conn *iot.Client, packageName, versionName string) error {
+	pkg, err := findPackageByName(ctx, conn, packageName)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.ToString(pkg.DefaultVersionName) != versionName {
+		return nil
+	}
+
+	input := &iot.UpdatePackageInput{
+		PackageName:         aws.String(packageName),
+		UnsetDefaultVersion: aws.Bool(true),
+	}
+
+	_, err = conn.UpdatePackage(ctx, input)
+
+	return err
+}
+
+func findPackageVersionByTwoPartKey(ctx context.Context, conn *iot.Client, packageName, versionName string) (*iot.GetPackageVersionOutput, error) {
+	input := &iot.GetPackageVersionInput{
+		PackageName: aws.String(packageName),
+		VersionName: aws.String(versionName),
+	}
+
+	output, err := conn.GetPackageVersion(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
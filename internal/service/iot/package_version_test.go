@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTPackageVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iot", regexache.MustCompile(fmt.Sprintf("package/%s/version/1.0.0$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttr(resourceName, "package_name", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTPackageVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourcePackageVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTPackageVersion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageVersionConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPackageVersionConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTPackageVersion_attributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_attributes(rName, "description 1", "x86_64"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attributes.architecture", "x86_64"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageVersionConfig_attributes(rName, "description 2", "arm64"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attributes.architecture", "arm64"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTPackageVersion_statusAndDefaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_package_version.test"
+	packageResourceName := "aws_iot_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageVersionConfig_status(rName, "PUBLISHED", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "PUBLISHED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageVersionConfig_status(rName, "PUBLISHED", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "PUBLISHED"),
+				),
+			},
+			{
+				Config: testAccPackageVersionConfig_status(rName, "DEPRECATED", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEPRECATED"),
+				),
+			},
+			{
+				// Refresh the package to pick up the default version changes made by the package version.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(packageResourceName, "default_version_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageVersionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTClient(ctx)
+
+		_, err := tfiot.FindPackageVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["package_name"], rs.Primary.Attributes["version_name"])
+
+		return err
+	}
+}
+
+func testAccCheckPackageVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_package_version" {
+				continue
+			}
+
+			_, err := tfiot.FindPackageVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["package_name"], rs.Primary.Attributes["version_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Package Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageVersionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_package" "test" {
+  package_name = %[1]q
+}
+`, rName)
+}
+
+func testAccPackageVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPackageVersionConfig_base(rName), `
+resource "aws_iot_package_version" "test" {
+  package_name = aws_iot_package.test.package_name
+  version_name = "1.0.0"
+}
+`)
+}
+
+func testAccPackageVersionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPackageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_package_version" "test" {
+  package_name = aws_iot_package.test.package_name
+  version_name = "1.0.0"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccPackageVersionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPackageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_package_version" "test" {
+  package_name = aws_iot_package.test.package_name
+  version_name = "1.0.0"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccPackageVersionConfig_attributes(rName, description, architecture string) string {
+	return acctest.ConfigCompose(testAccPackageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_package_version" "test" {
+  package_name = aws_iot_package.test.package_name
+  version_name = "1.0.0"
+  description  = %[1]q
+
+  attributes = {
+    architecture = %[2]q
+  }
+}
+`, description, architecture))
+}
+
+func testAccPackageVersionConfig_status(rName, status string, defaultVersion bool) string {
+	return acctest.ConfigCompose(testAccPackageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_iot_package_version" "test" {
+  package_name    = aws_iot_package.test.package_name
+  version_name    = "1.0.0"
+  status          = %[1]q
+  default_version = %[2]t
+}
+`, status, defaultVersion))
+}
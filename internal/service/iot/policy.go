@@ -4,6 +4,7 @@
 package iot
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iot/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -38,7 +41,11 @@ func resourcePolicy() *schema.Resource {
 		DeleteWithoutTimeout: resourcePolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("prune_versions", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -71,11 +78,24 @@ func resourcePolicy() *schema.Resource {
 					return json
 				},
 			},
+			"prune_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -133,6 +153,14 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set(names.AttrPolicy, policyToSet)
 
+	policyVersions, err := findPolicyVersionsByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Policy (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("versions", flattenPolicyVersionIDs(policyVersions))
+
 	return diags
 }
 
@@ -140,7 +168,7 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IoTClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("prune_versions", names.AttrTags, names.AttrTagsAll) {
 		policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -155,7 +183,7 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		_, errCreate := conn.CreatePolicyVersion(ctx, input)
 
 		// "VersionsLimitExceededException: The policy ... already has the maximum number of versions (5)"
-		if errs.IsA[*awstypes.VersionsLimitExceededException](errCreate) {
+		if errs.IsA[*awstypes.VersionsLimitExceededException](errCreate) && d.Get("prune_versions").(bool) {
 			// Prune the lowest version and retry.
 			policyVersions, err := findPolicyVersionsByName(ctx, conn, d.Id())
 
@@ -233,6 +261,15 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourcePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Updating the policy document creates a new version.
+	if d.Id() != "" && d.HasChange(names.AttrPolicy) {
+		return d.SetNewComputed("versions")
+	}
+
+	return nil
+}
+
 func findPolicyByName(ctx context.Context, conn *iot.Client, name string) (*iot.GetPolicyOutput, error) {
 	input := &iot.GetPolicyInput{
 		PolicyName: aws.String(name),
@@ -325,3 +362,19 @@ func deletePolicyVersion(ctx context.Context, conn *iot.Client, name, versionID
 
 	return nil
 }
+
+// flattenPolicyVersionIDs returns the policy's version IDs in ascending order.
+func flattenPolicyVersionIDs(apiObjects []awstypes.PolicyVersion) []string {
+	versionIDs := tfslices.ApplyToAll(apiObjects, func(v awstypes.PolicyVersion) string {
+		return aws.ToString(v.VersionId)
+	})
+
+	slices.SortFunc(versionIDs, func(a, b string) int {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+
+		return cmp.Compare(x, y)
+	})
+
+	return versionIDs
+}
@@ -6,8 +6,10 @@ package iot_test
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iot/types"
@@ -237,12 +239,49 @@ func TestAccIoTPolicy_prune(t *testing.T) {
 					testAccCheckPolicyExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "default_version_id", "7"),
 					testAccCheckPolicyVersionIDs(ctx, resourceName, []string{acctest.Ct3, acctest.Ct4, "5", "6", "7"}),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "versions.4", "7"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccIoTPolicy_pruneVersionsDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iot.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_policy.test"
+
+	steps := []resource.TestStep{}
+	for i := 1; i <= 5; i++ {
+		steps = append(steps, resource.TestStep{
+			// lintignore:AWSAT005
+			Config: testAccPolicyConfig_pruneVersions(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), false),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckPolicyExists(ctx, resourceName, &v),
+				resource.TestCheckResourceAttr(resourceName, "default_version_id", strconv.Itoa(i)),
+				resource.TestCheckResourceAttr(resourceName, "prune_versions", acctest.CtFalse),
+				resource.TestCheckResourceAttr(resourceName, "versions.#", strconv.Itoa(i)),
+			),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		// lintignore:AWSAT005
+		Config:      testAccPolicyConfig_pruneVersions(rName, fmt.Sprintf("arn:aws:iot:*:*:topic/%s", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)), false),
+		ExpectError: regexache.MustCompile(`VersionsLimitExceededException`),
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps:                    steps,
+	})
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTClient(ctx)
@@ -427,3 +466,29 @@ EOF
 }
 `, rName, resourceName)
 }
+
+func testAccPolicyConfig_pruneVersions(rName, resourceName string, pruneVersions bool) string {
+	return fmt.Sprintf(`
+resource "aws_iot_policy" "test" {
+  name           = %[1]q
+  prune_versions = %[3]t
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "iot:*"
+      ],
+      "Resource": [
+        %[2]q
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName, resourceName, pruneVersions)
+}
@@ -232,8 +232,13 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 			TemplateName:        aws.String(d.Id()),
 		}
 
-		if v, ok := d.GetOk("pre_provisioning_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			input.PreProvisioningHook = expandProvisioningHook(v.([]interface{})[0].(map[string]interface{}))
+		if d.HasChange("pre_provisioning_hook") {
+			if v, ok := d.GetOk("pre_provisioning_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PreProvisioningHook = expandProvisioningHook(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// Omitting the hook leaves the existing hook in place.
+				input.RemovePreProvisioningHook = aws.Bool(true)
+			}
 		}
 
 		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, propagationTimeout,
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -177,6 +178,61 @@ func TestAccIoTProvisioningTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTProvisioningTemplate_preProvisioningHook(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningTemplateConfig_preProvisioningHookEnabled(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.0.payload_version", "2020-04-01"),
+					resource.TestCheckResourceAttrPair(resourceName, "pre_provisioning_hook.0.target_arn", "aws_lambda_function.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_preProvisioningHookEnabled(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_preProvisioningHookRemoved(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckProvisioningTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -427,6 +483,65 @@ resource "aws_iot_provisioning_template" "test" {
 `, rName))
 }
 
+func testAccProvisioningTemplateConfig_preProvisioningHookEnabled(rName string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccProvisioningTemplateBaseConfig(rName),
+		testAccProvisioningTemplateConfig_preProvisioningHook(rName),
+		fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+  enabled               = %[2]t
+
+  pre_provisioning_hook {
+    target_arn = aws_lambda_function.test.arn
+  }
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String" }
+    }
+
+    Resources = {
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+}
+`, rName, enabled))
+}
+
+func testAccProvisioningTemplateConfig_preProvisioningHookRemoved(rName string) string {
+	return acctest.ConfigCompose(
+		testAccProvisioningTemplateBaseConfig(rName),
+		testAccProvisioningTemplateConfig_preProvisioningHook(rName),
+		fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String" }
+    }
+
+    Resources = {
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+}
+`, rName))
+}
+
 func testAccProvisioningTemplateConfig_preProvisioningHook(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test2" {
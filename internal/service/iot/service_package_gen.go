@@ -81,6 +81,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iot_event_configurations",
 			Name:     "Event Configurations",
 		},
+		{
+			Factory:  resourceFleetMetric,
+			TypeName: "aws_iot_fleet_metric",
+			Name:     "Fleet Metric",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceIndexingConfiguration,
 			TypeName: "aws_iot_indexing_configuration",
@@ -91,6 +99,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iot_logging_options",
 			Name:     "Logging Options",
 		},
+		{
+			Factory:  resourcePackage,
+			TypeName: "aws_iot_package",
+			Name:     "Package",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourcePackageVersion,
+			TypeName: "aws_iot_package_version",
+			Name:     "Package Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourcePolicy,
 			TypeName: "aws_iot_policy",
@@ -99,6 +99,24 @@ func RegisterSweepers() {
 		F:    sweepDomainConfigurations,
 	})
 
+	resource.AddTestSweepers("aws_iot_fleet_metric", &resource.Sweeper{
+		Name: "aws_iot_fleet_metric",
+		F:    sweepFleetMetrics,
+	})
+
+	resource.AddTestSweepers("aws_iot_package", &resource.Sweeper{
+		Name: "aws_iot_package",
+		F:    sweepPackages,
+		Dependencies: []string{
+			"aws_iot_package_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iot_package_version", &resource.Sweeper{
+		Name: "aws_iot_package_version",
+		F:    sweepPackageVersions,
+	})
+
 	resource.AddTestSweepers("aws_iot_ca_certificate", &resource.Sweeper{
 		Name: "aws_iot_ca_certificate",
 		F:    sweepCACertificates,
@@ -712,3 +730,144 @@ func sweepCACertificates(region string) error {
 
 	return nil
 }
+
+func sweepFleetMetrics(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTClient(ctx)
+	input := &iot.ListFleetMetricsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := iot.NewListFleetMetricsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Fleet Metric sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Fleet Metrics (%s): %w", region, err)
+		}
+
+		for _, v := range page.FleetMetrics {
+			r := resourceFleetMetric()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.MetricName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Fleet Metrics (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPackages(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTClient(ctx)
+	input := &iot.ListPackagesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := iot.NewListPackagesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Package sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Packages (%s): %w", region, err)
+		}
+
+		for _, v := range page.PackageSummaries {
+			r := resourcePackage()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.PackageName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Packages (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPackageVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTClient(ctx)
+	input := &iot.ListPackagesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := iot.NewListPackagesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Package Version sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing IoT Packages (%s): %w", region, err)
+		}
+
+		for _, v := range page.PackageSummaries {
+			packageName := aws.ToString(v.PackageName)
+			input := &iot.ListPackageVersionsInput{
+				PackageName: aws.String(packageName),
+			}
+
+			pages := iot.NewListPackageVersionsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return fmt.Errorf("error listing IoT Package (%s) Versions (%s): %w", packageName, region, err)
+				}
+
+				for _, v := range page.PackageVersionSummaries {
+					r := resourcePackageVersion()
+					d := r.Data(nil)
+					d.SetId(packageVersionCreateResourceID(packageName, aws.ToString(v.VersionName)))
+					// Ensure that the package's default version is unset before deletion.
+					d.Set("default_version", true)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Package Versions (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package iot
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
 
@@ -1259,6 +1260,10 @@ func resourceTopicRuleCreate(ctx context.Context, d *schema.ResourceData, meta i
 		TopicRulePayload: expandTopicRulePayload(d),
 	}
 
+	if err := waitTopicRuleKafkaDestinationsEnabled(ctx, conn, input.TopicRulePayload); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Topic Rule (%s): %s", ruleName, err)
+	}
+
 	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, propagationTimeout,
 		func() (interface{}, error) {
 			return conn.CreateTopicRule(ctx, input)
@@ -1389,6 +1394,10 @@ func resourceTopicRuleUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			TopicRulePayload: expandTopicRulePayload(d),
 		}
 
+		if err := waitTopicRuleKafkaDestinationsEnabled(ctx, conn, input.TopicRulePayload); err != nil {
+			return sdkdiag.AppendErrorf(diags, "replacing IoT Topic Rule (%s): %s", d.Id(), err)
+		}
+
 		_, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, propagationTimeout,
 			func() (interface{}, error) {
 				return conn.ReplaceTopicRule(ctx, input)
@@ -1418,6 +1427,42 @@ func resourceTopicRuleDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// waitTopicRuleKafkaDestinationsEnabled waits for any VPC topic rule destinations used by Kafka actions
+// that are still being created, e.g. in the same apply, to become enabled.
+func waitTopicRuleKafkaDestinationsEnabled(ctx context.Context, conn *iot.Client, apiObject *awstypes.TopicRulePayload) error {
+	actions := apiObject.Actions
+	if apiObject.ErrorAction != nil {
+		actions = append(actions, *apiObject.ErrorAction)
+	}
+
+	for _, action := range actions {
+		if action.Kafka == nil {
+			continue
+		}
+
+		arn := aws.ToString(action.Kafka.DestinationArn)
+		destination, err := findTopicRuleDestinationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading IoT Topic Rule Destination (%s): %w", arn, err)
+		}
+
+		if destination.Status != awstypes.TopicRuleDestinationStatusInProgress {
+			continue
+		}
+
+		if _, err := waitTopicRuleDestinationEnabled(ctx, conn, arn, topicRuleDestinationEnabledTimeout); err != nil {
+			return fmt.Errorf("waiting for IoT Topic Rule Destination (%s) enable: %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
 func findTopicRuleByName(ctx context.Context, conn *iot.Client, name string) (*iot.GetTopicRuleOutput, error) {
 	// GetTopicRule returns unhelpful errors such as
 	//	"An error occurred (UnauthorizedException) when calling the GetTopicRule operation: Access to topic rule 'xxxxxxxx' was denied"
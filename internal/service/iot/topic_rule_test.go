@@ -1572,6 +1572,44 @@ func TestAccIoTTopicRule_kafka(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_Kafka_saslSCRAM(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_kafkaSASLSCRAM(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kafka.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "kafka.*", map[string]string{
+						"client_properties.%":                 "7",
+						"client_properties.bootstrap.servers": "b-1.localhost:9096",
+						"client_properties.sasl.mechanism":    "SCRAM-SHA-512",
+						"client_properties.security.protocol": "SASL_SSL",
+						"topic":                               "fake_topic",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.client_properties.sasl.mechanism", "SCRAM-SHA-512"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.kafka.0.topic", "fake_error_topic"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
 func TestAccIoTTopicRule_kinesis(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -2996,6 +3034,52 @@ resource "aws_iot_topic_rule" "test" {
 `, rName, topic, broker))
 }
 
+func testAccTopicRuleConfig_kafkaSASLSCRAM(rName string) string {
+	// Making a topic rule destination takes several minutes, as it requires creating many networking resources.
+	// It's far faster to simply use a properly-formatted but nonexistent ARN for the destination.
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+locals {
+  destination_arn = "arn:${data.aws_partition.current.partition}:iot:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:ruledestination/vpc/pretend-this-is-a-uuid"
+
+  client_properties = {
+    "bootstrap.servers"   = "b-1.localhost:9096"
+    "key.serializer"      = "org.apache.kafka.common.serialization.StringSerializer"
+    "sasl.mechanism"      = "SCRAM-SHA-512"
+    "sasl.scram.password" = "$${get_secret('AmazonMSK_secret_name', 'SecretString', 'password', '${aws_iam_role.test.arn}')}"
+    "sasl.scram.username" = "$${get_secret('AmazonMSK_secret_name', 'SecretString', 'username', '${aws_iam_role.test.arn}')}"
+    "security.protocol"   = "SASL_SSL"
+    "value.serializer"    = "org.apache.kafka.common.serialization.ByteBufferSerializer"
+  }
+}
+
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  kafka {
+    destination_arn   = local.destination_arn
+    topic             = "fake_topic"
+    client_properties = local.client_properties
+  }
+
+  error_action {
+    kafka {
+      destination_arn   = local.destination_arn
+      topic             = "fake_error_topic"
+      client_properties = local.client_properties
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTopicRuleConfig_kinesis(rName string, streamName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +44,10 @@ func ResourceApplication() *schema.Resource {
 				// An existing input configuration cannot be deleted.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			customdiff.ForceNewIfChange("runtime_environment", func(_ context.Context, old, new, meta interface{}) bool {
+				// Only upgrades to a newer Flink runtime can be applied in-place.
+				return !isFlinkRuntimeEnvironmentUpgrade(old.(string), new.(string))
+			}),
 		),
 
 		Importer: &schema.ResourceImporter{
@@ -815,7 +820,10 @@ func ResourceApplication() *schema.Resource {
 										Required: true,
 										MinItems: 1,
 										MaxItems: 5,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidSecurityGroupID,
+										},
 									},
 
 									names.AttrSubnetIDs: {
@@ -823,7 +831,10 @@ func ResourceApplication() *schema.Resource {
 										Required: true,
 										MinItems: 1,
 										MaxItems: 16,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidSubnetID,
+										},
 									},
 
 									"vpc_configuration_id": {
@@ -911,14 +922,13 @@ func ResourceApplication() *schema.Resource {
 			"runtime_environment": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RuntimeEnvironment_Values(), false),
 			},
 
 			"service_execution_role": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidIAMRoleARN,
 			},
 
 			"start_application": {
@@ -1028,7 +1038,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).KinesisAnalyticsV2Conn(ctx)
 	applicationName := d.Get(names.AttrName).(string)
 
-	if d.HasChanges("application_configuration", "cloudwatch_logging_options", "service_execution_role") {
+	if d.HasChanges("application_configuration", "cloudwatch_logging_options", "runtime_environment", "service_execution_role") {
 		currentApplicationVersionId := int64(d.Get("version_id").(int))
 		updateApplication := false
 
@@ -1475,6 +1485,12 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			}
 		}
 
+		if d.HasChange("runtime_environment") {
+			input.RuntimeEnvironmentUpdate = aws.String(d.Get("runtime_environment").(string))
+
+			updateApplication = true
+		}
+
 		if d.HasChange("service_execution_role") {
 			input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
 
@@ -1566,6 +1582,51 @@ func resourceApplicationImport(ctx context.Context, d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+// isFlinkRuntimeEnvironmentUpgrade returns whether or not the runtime environment change
+// is an upgrade from one Apache Flink version to a newer one.
+func isFlinkRuntimeEnvironmentUpgrade(old, new string) bool {
+	oldVersion, ok := parseFlinkRuntimeEnvironment(old)
+	if !ok {
+		return false
+	}
+
+	newVersion, ok := parseFlinkRuntimeEnvironment(new)
+	if !ok {
+		return false
+	}
+
+	if oldVersion[0] != newVersion[0] {
+		return newVersion[0] > oldVersion[0]
+	}
+
+	return newVersion[1] > oldVersion[1]
+}
+
+// parseFlinkRuntimeEnvironment parses a runtime environment of the form FLINK-<major>_<minor>.
+func parseFlinkRuntimeEnvironment(v string) ([2]int, bool) {
+	var version [2]int
+
+	s, ok := strings.CutPrefix(v, "FLINK-")
+	if !ok {
+		return version, false
+	}
+
+	parts := strings.Split(s, "_")
+	if len(parts) != 2 {
+		return version, false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return version, false
+		}
+		version[i] = n
+	}
+
+	return version, true
+}
+
 func startApplication(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, input *kinesisanalyticsv2.StartApplicationInput, timeout time.Duration) error {
 	applicationName := aws.StringValue(input.ApplicationName)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -114,7 +115,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct2),
 				),
 			},
 			{
@@ -156,7 +157,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct3),
 				),
 			},
 			{
@@ -198,7 +199,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct4),
 				),
 			},
 			{
@@ -240,7 +241,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "5"),
 				),
 			},
 			{
@@ -282,7 +283,7 @@ func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "start_application"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "6"),
 				),
 			},
 			{
@@ -388,6 +389,56 @@ func TestAccKinesisAnalyticsV2Application_disappears(t *testing.T) {
 	})
 }
 
+func TestAccKinesisAnalyticsV2Application_RuntimeEnvironment_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kinesisanalyticsv2.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisAnalyticsV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basicFlink(rName, "FLINK-1_15"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "runtime_environment", "FLINK-1_15"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_basicFlink(rName, "FLINK-1_18"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "runtime_environment", "FLINK-1_18"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_basicFlink(rName, "FLINK-1_15"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "runtime_environment", "FLINK-1_15"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKinesisAnalyticsV2Application_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v kinesisanalyticsv2.ApplicationDetail
@@ -136,6 +136,9 @@ func (r *resourceDataCellsFilter) Schema(ctx context.Context, _ resource.SchemaR
 									"filter_expression": schema.StringAttribute{
 										Optional: true,
 										Computed: true,
+										Validators: []validator.String{
+											validFilterExpression(),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -9,10 +9,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -88,6 +90,19 @@ func testAccDataCellsFilter_columnWildcard(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.0", "my_column_12"),
 				),
 			},
+			{
+				Config: testAccDataCellsFilterConfig_columnWildcard(rName, "my_column_22"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &datacellsfilter),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.column_wildcard.0.excluded_column_names.0", "my_column_22"),
+				),
+			},
 		},
 	})
 }
@@ -130,6 +145,9 @@ func testAccDataCellsFilter_rowFilter(t *testing.T) {
 
 	filterExpression := `
   filter_expression = "my_column_23='testing'"
+`
+	filterExpressionUpdated := `
+  filter_expression = "my_column_23='testing' AND my_column_12 IS NOT NULL"
 `
 	allRowsildcard := `
   all_rows_wildcard {}
@@ -156,6 +174,22 @@ func testAccDataCellsFilter_rowFilter(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "my_column_23='testing'"),
 				),
 			},
+			{
+				Config: testAccDataCellsFilterConfig_rowFilter(rName, filterExpressionUpdated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &datacellsfilter),
+					resource.TestCheckResourceAttr(resourceName, "table_data.0.row_filter.0.filter_expression", "my_column_23='testing' AND my_column_12 IS NOT NULL"),
+				),
+			},
+			{
+				Config:      testAccDataCellsFilterConfig_rowFilter(rName, "\n  filter_expression = \"my_column_23='testing\"\n"),
+				ExpectError: regexache.MustCompile(`unterminated string literal`),
+			},
 			{
 				Config: testAccDataCellsFilterConfig_rowFilter(rName, allRowsildcard),
 				Check: resource.ComposeTestCheckFunc(
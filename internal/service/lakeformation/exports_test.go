@@ -6,8 +6,10 @@ package lakeformation
 // exports used for testing only.
 var (
 	ResourceDataCellsFilter = newResourceDataCellsFilter
+	ResourceOptIn           = resourceOptIn
 	ResourceResourceLFTag   = newResourceResourceLFTag
 
-	FindDataCellsFilterByID = findDataCellsFilterByID
-	FindResourceLFTagByID   = findResourceLFTagByID
+	FindDataCellsFilterByID         = findDataCellsFilterByID
+	FindOptInByPrincipalAndResource = findOptInByPrincipalAndResource
+	FindResourceLFTagByID           = findResourceLFTagByID
 )
@@ -29,6 +29,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			acctest.CtBasic:  testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"OptIn": {
+			acctest.CtBasic:      testAccOptIn_basic,
+			acctest.CtDisappears: testAccOptIn_disappears,
+			"table":              testAccOptIn_table,
+		},
 		"PermissionsBasic": {
 			acctest.CtBasic:       testAccPermissions_basic,
 			"database":            testAccPermissions_database,
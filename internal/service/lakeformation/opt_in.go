@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lakeformation_opt_in", name="Opt In")
+func resourceOptIn() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOptInCreate,
+		ReadWithoutTimeout:   resourceOptInRead,
+		DeleteWithoutTimeout: resourceOptInDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDatabase: {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					names.AttrDatabase,
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCatalogID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrPrincipal: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validPrincipal,
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					names.AttrDatabase,
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCatalogID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						names.AttrDatabaseName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ExactlyOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							ExactlyOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOptInCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	principal := &awstypes.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(d.Get(names.AttrPrincipal).(string)),
+	}
+	resource := expandOptInResource(d)
+	input := &lakeformation.CreateLakeFormationOptInInput{
+		Principal: principal,
+		Resource:  resource,
+	}
+
+	_, err := tfresource.RetryWhen(ctx, IAMPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateLakeFormationOptIn(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsAErrorMessageContains[*awstypes.InvalidInputException](err, "Invalid principal") {
+				return true, err
+			}
+			if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Opt In (%s): %s", d.Get(names.AttrPrincipal).(string), err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(prettify(input))))
+
+	return append(diags, resourceOptInRead(ctx, d, meta)...)
+}
+
+func resourceOptInRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	principal := &awstypes.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(d.Get(names.AttrPrincipal).(string)),
+	}
+	output, err := findOptInByPrincipalAndResource(ctx, conn, principal, expandOptInResource(d))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Opt In (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Opt In (%s): %s", d.Id(), err)
+	}
+
+	if output.Resource != nil {
+		if v := output.Resource.Database; v != nil {
+			if err := d.Set(names.AttrDatabase, []interface{}{flattenDatabaseResource(v)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting database: %s", err)
+			}
+		}
+		if v := output.Resource.Table; v != nil {
+			if err := d.Set("table", []interface{}{flattenTableResource(v)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting table: %s", err)
+			}
+		}
+	}
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.ToTime(output.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("last_updated_by", output.LastUpdatedBy)
+	if output.Principal != nil {
+		d.Set(names.AttrPrincipal, output.Principal.DataLakePrincipalIdentifier)
+	}
+
+	return diags
+}
+
+func resourceOptInDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	log.Printf("[INFO] Deleting Lake Formation Opt In: %s", d.Id())
+	_, err := conn.DeleteLakeFormationOptIn(ctx, &lakeformation.DeleteLakeFormationOptInInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get(names.AttrPrincipal).(string)),
+		},
+		Resource: expandOptInResource(d),
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Opt In (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandOptInResource(d *schema.ResourceData) *awstypes.Resource {
+	apiObject := &awstypes.Resource{}
+
+	if v, ok := d.GetOk(names.AttrDatabase); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func findOptInByPrincipalAndResource(ctx context.Context, conn *lakeformation.Client, principal *awstypes.DataLakePrincipal, resource *awstypes.Resource) (*awstypes.LakeFormationOptInsInfo, error) {
+	input := &lakeformation.ListLakeFormationOptInsInput{
+		Principal: principal,
+		Resource:  resource,
+	}
+
+	return findOptIn(ctx, conn, input)
+}
+
+func findOptIn(ctx context.Context, conn *lakeformation.Client, input *lakeformation.ListLakeFormationOptInsInput) (*awstypes.LakeFormationOptInsInfo, error) {
+	output, err := findOptIns(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findOptIns(ctx context.Context, conn *lakeformation.Client, input *lakeformation.ListLakeFormationOptInsInput) ([]awstypes.LakeFormationOptInsInfo, error) {
+	var output []awstypes.LakeFormationOptInsInfo
+
+	for {
+		page, err := conn.ListLakeFormationOptIns(ctx, input)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.LakeFormationOptInsInfoList...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOptIn_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrPrincipal, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "database.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", "aws_glue_catalog_database.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "table.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccOptIn_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceOptIn(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOptIn_table(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "database.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "table.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.database_name", "aws_glue_catalog_table.test", names.AttrDatabaseName),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", "aws_glue_catalog_table.test", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOptInDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_opt_in" {
+				continue
+			}
+
+			_, err := tflakeformation.FindOptInByPrincipalAndResource(ctx, conn, testAccOptInPrincipal(rs), testAccOptInResource(rs))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation Opt In %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOptInExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		_, err := tflakeformation.FindOptInByPrincipalAndResource(ctx, conn, testAccOptInPrincipal(rs), testAccOptInResource(rs))
+
+		return err
+	}
+}
+
+func testAccOptInPrincipal(rs *terraform.ResourceState) *awstypes.DataLakePrincipal {
+	return &awstypes.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes[names.AttrPrincipal]),
+	}
+}
+
+func testAccOptInResource(rs *terraform.ResourceState) *awstypes.Resource {
+	apiObject := &awstypes.Resource{}
+
+	if rs.Primary.Attributes["database.#"] == acctest.Ct1 {
+		apiObject.Database = &awstypes.DatabaseResource{
+			CatalogId: aws.String(rs.Primary.Attributes["database.0.catalog_id"]),
+			Name:      aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	}
+
+	if rs.Primary.Attributes["table.#"] == acctest.Ct1 {
+		apiObject.Table = &awstypes.TableResource{
+			CatalogId:    aws.String(rs.Primary.Attributes["table.0.catalog_id"]),
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+		}
+		if v := rs.Primary.Attributes["table.0.name"]; v != "" {
+			apiObject.Table.Name = aws.String(v)
+		}
+		if rs.Primary.Attributes["table.0.wildcard"] == acctest.CtTrue {
+			apiObject.Table.TableWildcard = &awstypes.TableWildcard{}
+		}
+	}
+
+	return apiObject
+}
+
+func testAccOptInConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccOptInConfig_database(rName string) string {
+	return acctest.ConfigCompose(testAccOptInConfigBase(rName), `
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["DESCRIBE"]
+  principal   = aws_iam_role.test.arn
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_opt_in" "test" {
+  principal = aws_iam_role.test.arn
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  depends_on = [aws_lakeformation_permissions.test]
+}
+`)
+}
+
+func testAccOptInConfig_table(rName string) string {
+	return acctest.ConfigCompose(testAccOptInConfigBase(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["SELECT"]
+  principal   = aws_iam_role.test.arn
+
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_opt_in" "test" {
+  principal = aws_iam_role.test.arn
+
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  depends_on = [aws_lakeformation_permissions.test]
+}
+`, rName))
+}
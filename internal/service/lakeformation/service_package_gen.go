@@ -58,6 +58,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
 		},
+		{
+			Factory:  resourceOptIn,
+			TypeName: "aws_lakeformation_opt_in",
+			Name:     "Opt In",
+		},
 		{
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
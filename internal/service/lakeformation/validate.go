@@ -4,9 +4,13 @@
 package lakeformation
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -40,3 +44,107 @@ func validPrincipal(v interface{}, k string) (ws []string, errors []error) {
 
 	return ws, errors
 }
+
+// filterExpressionValidator validates that a data cells filter row filter expression
+// is plausibly valid PartiQL. Only structural checks are performed; Lake Formation
+// validates column names and operators when the filter is created.
+type filterExpressionValidator struct{}
+
+func (v filterExpressionValidator) Description(_ context.Context) string {
+	return "value must be a PartiQL row filter expression"
+}
+
+func (v filterExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v filterExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkFilterExpression(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Row Filter Expression",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+func validFilterExpression() validator.String {
+	return filterExpressionValidator{}
+}
+
+func checkFilterExpression(expression string) error {
+	if strings.TrimSpace(expression) == "" {
+		return errors.New("expression must not be empty")
+	}
+
+	var (
+		depth        int
+		inString     bool // '...' string literal, '' escapes a quote.
+		inIdentifier bool // "..." quoted identifier, "" escapes a quote.
+	)
+
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+
+		switch {
+		case inString:
+			if c == '\'' {
+				if i+1 < len(expression) && expression[i+1] == '\'' {
+					i++
+				} else {
+					inString = false
+				}
+			}
+		case inIdentifier:
+			if c == '"' {
+				if i+1 < len(expression) && expression[i+1] == '"' {
+					i++
+				} else {
+					inIdentifier = false
+				}
+			}
+		default:
+			switch c {
+			case '\'':
+				inString = true
+			case '"':
+				inIdentifier = true
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth < 0 {
+					return fmt.Errorf("unexpected ')' at position %d", i+1)
+				}
+			case ';':
+				return fmt.Errorf("unexpected ';' at position %d", i+1)
+			case '-':
+				if i+1 < len(expression) && expression[i+1] == '-' {
+					return fmt.Errorf("comments are not supported (position %d)", i+1)
+				}
+			case '/':
+				if i+1 < len(expression) && expression[i+1] == '*' {
+					return fmt.Errorf("comments are not supported (position %d)", i+1)
+				}
+			}
+		}
+	}
+
+	if inString {
+		return errors.New("unterminated string literal")
+	}
+
+	if inIdentifier {
+		return errors.New("unterminated quoted identifier")
+	}
+
+	if depth > 0 {
+		return errors.New("unbalanced parentheses")
+	}
+
+	return nil
+}
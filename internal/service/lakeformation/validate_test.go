@@ -61,3 +61,39 @@ func TestValidPrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckFilterExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"my_column_23='testing'",
+		"region = 'us-east-1' AND (amount > 100 OR status IN ('a', 'b'))",
+		"name LIKE 'O''Brien%'",
+		`"select" IS NOT NULL`,
+		`"a""b" = 1`,
+		"note = 'semicolons; -- and /* are fine in literals'",
+		"value BETWEEN 1 AND 10",
+	}
+	for _, v := range validExpressions {
+		if err := checkFilterExpression(v); err != nil {
+			t.Errorf("%q should be a valid filter expression: %s", v, err)
+		}
+	}
+
+	invalidExpressions := []string{
+		"",
+		"   ",
+		"region = 'us-east-1",
+		`"region = 'us-east-1'`,
+		"(a = 1",
+		"a = 1)",
+		"a = 1; DROP TABLE t",
+		"a = 1 -- comment",
+		"a = 1 /* comment */",
+	}
+	for _, v := range invalidExpressions {
+		if err := checkFilterExpression(v); err == nil {
+			t.Errorf("%q should be an invalid filter expression", v)
+		}
+	}
+}
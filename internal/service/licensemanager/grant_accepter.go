@@ -5,11 +5,14 @@ package licensemanager
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -28,13 +31,25 @@ func ResourceGrantAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantAccepterCreate,
 		ReadWithoutTimeout:   resourceGrantAccepterRead,
+		UpdateWithoutTimeout: resourceGrantAccepterUpdate,
 		DeleteWithoutTimeout: resourceGrantAccepterDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to activate the grant once it has been accepted.",
+			},
 			"allowed_operations": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -106,6 +121,18 @@ func resourceGrantAccepterCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(aws.StringValue(out.GrantArn))
 
+	grant, err := waitGrantAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionWaitingForCreation, ResGrantAccepter, d.Id(), err)
+	}
+
+	if d.Get("activate").(bool) && aws.StringValue(grant.GrantStatus) != licensemanager.GrantStatusActive {
+		if err := updateGrantAccepterStatus(ctx, conn, d.Id(), licensemanager.GrantStatusActive, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionCreating, ResGrantAccepter, d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGrantAccepterRead(ctx, d, meta)...)
 }
 
@@ -139,6 +166,25 @@ func resourceGrantAccepterRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceGrantAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	if d.HasChange("activate") {
+		status := licensemanager.GrantStatusDisabled
+		if d.Get("activate").(bool) {
+			status = licensemanager.GrantStatusActive
+		}
+
+		if err := updateGrantAccepterStatus(ctx, conn, d.Id(), status, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionUpdating, ResGrantAccepter, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGrantAccepterRead(ctx, d, meta)...)
+}
+
 func resourceGrantAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -157,7 +203,41 @@ func resourceGrantAccepterDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+func updateGrantAccepterStatus(ctx context.Context, conn *licensemanager.LicenseManager, arn, status string, timeout time.Duration) error {
+	in := &licensemanager.CreateGrantVersionInput{
+		ClientToken: aws.String(id.UniqueId()),
+		GrantArn:    aws.String(arn),
+		Status:      aws.String(status),
+	}
+
+	_, err := conn.CreateGrantVersionWithContext(ctx, in)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waitGrantAccepterStatus(ctx, conn, arn, status, timeout)
+
+	return err
+}
+
 func FindGrantAccepterByGrantARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
+	out, err := findReceivedGrantByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(out.GrantStatus); status != licensemanager.GrantStatusActive && status != licensemanager.GrantStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return out, nil
+}
+
+func findReceivedGrantByARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
 	in := &licensemanager.ListReceivedGrantsInput{
 		GrantArns: aws.StringSlice([]string{arn}),
 	}
@@ -171,20 +251,69 @@ func FindGrantAccepterByGrantARN(ctx context.Context, conn *licensemanager.Licen
 		}
 	}
 
-	var entry *licensemanager.Grant
-	entryExists := false
+	if err != nil {
+		return nil, err
+	}
 
 	for _, grant := range out.Grants {
-		if arn == aws.StringValue(grant.GrantArn) && (licensemanager.GrantStatusActive == aws.StringValue(grant.GrantStatus) || licensemanager.GrantStatusDisabled == aws.StringValue(grant.GrantStatus)) {
-			entry = grant
-			entryExists = true
-			break
+		if arn == aws.StringValue(grant.GrantArn) {
+			return grant, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func statusGrantAccepter(ctx context.Context, conn *licensemanager.LicenseManager, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findReceivedGrantByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
 		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.GrantStatus), nil
+	}
+}
+
+func waitGrantAccepted(ctx context.Context, conn *licensemanager.LicenseManager, arn string, timeout time.Duration) (*licensemanager.Grant, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{licensemanager.GrantStatusPendingAccept, licensemanager.GrantStatusPendingWorkflow, licensemanager.GrantStatusWorkflowCompleted},
+		Target:  []string{licensemanager.GrantStatusActive, licensemanager.GrantStatusDisabled},
+		Refresh: statusGrantAccepter(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.Grant); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGrantAccepterStatus(ctx context.Context, conn *licensemanager.LicenseManager, arn, status string, timeout time.Duration) (*licensemanager.Grant, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{licensemanager.GrantStatusActive, licensemanager.GrantStatusDisabled, licensemanager.GrantStatusPendingWorkflow, licensemanager.GrantStatusWorkflowCompleted},
+		Target:  []string{status},
+		Refresh: statusGrantAccepter(ctx, conn, arn),
+		Timeout: timeout,
 	}
 
-	if !entryExists {
-		return nil, tfresource.NewEmptyResultError(in)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.Grant); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
 	}
 
-	return entry, nil
+	return nil, err
 }
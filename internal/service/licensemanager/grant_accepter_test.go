@@ -56,10 +56,49 @@ func testAccGrantAccepter_basic(t *testing.T) {
 				),
 			},
 			{
-				Config:            testAccGrantAccepterConfig_basic(licenseARN, rName, principal, homeRegion),
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:                  testAccGrantAccepterConfig_basic(licenseARN, rName, principal, homeRegion),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate"},
+			},
+		},
+	})
+}
+
+func testAccGrantAccepter_activate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	licenseARN := envvar.SkipIfEmpty(t, licenseARNKey, envVarLicenseARNKeyError)
+	principal := envvar.SkipIfEmpty(t, principalKey, envVarPrincipalKeyError)
+	homeRegion := envvar.SkipIfEmpty(t, homeRegionKey, envVarHomeRegionError)
+	resourceName := "aws_licensemanager_grant_accepter.test"
+
+	providers := make(map[string]*schema.Provider)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             acctest.CheckWithNamedProviders(testAccCheckGrantAccepterDestroyWithProvider(ctx), providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantAccepterConfig_activate(licenseARN, rName, principal, homeRegion, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, "activate", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccGrantAccepterConfig_activate(licenseARN, rName, principal, homeRegion, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, "activate", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+				),
 			},
 		},
 	})
@@ -189,3 +228,46 @@ resource "aws_licensemanager_grant" "test" {
 `, licenseARN, rName, principal),
 	)
 }
+
+func testAccGrantAccepterConfig_activate(licenseARN, rName, principal, homeRegion string, activate bool) string {
+	principalArn, _ := arn.Parse(principal)
+	roleARN := arn.ARN{
+		Partition: principalArn.Partition,
+		Service:   "iam",
+		AccountID: principalArn.AccountID,
+		Resource:  "role/OrganizationAccountAccessRole",
+	}
+	return acctest.ConfigCompose(
+		acctest.ConfigNamedRegionalProvider(acctest.ProviderNameAlternate, homeRegion),
+		fmt.Sprintf(`
+provider %[1]q {
+	assume_role {
+		role_arn = %[2]q
+	}
+}`, acctest.ProviderName, roleARN),
+		fmt.Sprintf(`
+resource "aws_licensemanager_grant_accepter" "test" {
+  grant_arn = aws_licensemanager_grant.test.arn
+  activate  = %[4]t
+}
+
+data "aws_licensemanager_received_license" "test" {
+  provider    = awsalternate
+  license_arn = %[1]q
+}
+
+locals {
+  allowed_operations = [for i in data.aws_licensemanager_received_license.test.received_metadata[0].allowed_operations : i if i != "CreateGrant"]
+}
+
+resource "aws_licensemanager_grant" "test" {
+  provider = awsalternate
+
+  name               = %[2]q
+  allowed_operations = local.allowed_operations
+  license_arn        = data.aws_licensemanager_received_license.test.license_arn
+  principal          = %[3]q
+}
+`, licenseARN, rName, principal, activate),
+	)
+}
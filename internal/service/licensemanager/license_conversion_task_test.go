@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tflicensemanager "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	conversionResourceARNKey = "TF_AWS_LICENSE_MANAGER_CONVERSION_RESOURCE_ARN"
+)
+
+const (
+	envVarConversionResourceARNKeyError = "ARN of an EC2 instance eligible for license type conversion from BYOL to license included."
+)
+
+func TestAccLicenseManagerLicenseConversionTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceARN := envvar.SkipIfEmpty(t, conversionResourceARNKey, envVarConversionResourceARNKeyError)
+	resourceName := "aws_licensemanager_license_conversion_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseConversionTaskConfig_basic(resourceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConversionTaskExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_usage_operation", "RunInstances:0002"),
+					resource.TestCheckResourceAttrSet(resourceName, "license_conversion_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceARN, resourceARN),
+					resource.TestCheckResourceAttr(resourceName, "source_usage_operation", "RunInstances:0800"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseConversionTaskExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn(ctx)
+
+		_, err := tflicensemanager.FindLicenseConversionTaskByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccLicenseConversionTaskConfig_basic(resourceARN string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_conversion_task" "test" {
+  resource_arn                = %[1]q
+  source_usage_operation      = "RunInstances:0800"
+  destination_usage_operation = "RunInstances:0002"
+}
+`, resourceARN)
+}
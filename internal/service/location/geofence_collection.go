@@ -5,8 +5,11 @@ package location
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,10 +21,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
 )
 
 // @SDKResource("aws_location_geofence_collection", name="Geofence Collection")
@@ -63,6 +69,19 @@ func ResourceGeofenceCollection() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1000),
 			},
+			"geofence_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"geofences_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"geofences_file_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			names.AttrKMSKeyID: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -114,6 +133,17 @@ func resourceGeofenceCollectionCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(aws.StringValue(out.CollectionName))
 
+	if v, ok := d.GetOk("geofences_file"); ok {
+		geofences, err := readGeofencesFile(v.(string))
+		if err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameGeofenceCollection, d.Id(), err)
+		}
+
+		if err := putGeofences(ctx, conn, d.Id(), geofences); err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameGeofenceCollection, d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGeofenceCollectionRead(ctx, d, meta)...)
 }
 
@@ -141,6 +171,14 @@ func resourceGeofenceCollectionRead(ctx context.Context, d *schema.ResourceData,
 	d.Set(names.AttrKMSKeyID, out.KmsKeyId)
 	d.Set("update_time", aws.TimeValue(out.UpdateTime).Format(time.RFC3339))
 
+	geofenceIDs, err := findGeofenceIDsByCollectionName(ctx, conn, d.Id())
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionReading, ResNameGeofenceCollection, d.Id(), err)
+	}
+
+	d.Set("geofence_ids", geofenceIDs)
+
 	return diags
 }
 
@@ -149,25 +187,50 @@ func resourceGeofenceCollectionUpdate(ctx context.Context, d *schema.ResourceDat
 
 	conn := meta.(*conns.AWSClient).LocationConn(ctx)
 
-	update := false
+	if d.HasChange(names.AttrDescription) {
+		in := &locationservice.UpdateGeofenceCollectionInput{
+			CollectionName: aws.String(d.Id()),
+			Description:    aws.String(d.Get(names.AttrDescription).(string)),
+		}
 
-	in := &locationservice.UpdateGeofenceCollectionInput{
-		CollectionName: aws.String(d.Id()),
+		log.Printf("[DEBUG] Updating Location GeofenceCollection (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateGeofenceCollectionWithContext(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofenceCollection, d.Id(), err)
+		}
 	}
 
-	if d.HasChange(names.AttrDescription) {
-		in.Description = aws.String(d.Get(names.AttrDescription).(string))
-		update = true
-	}
+	if d.HasChanges("geofences_file", "geofences_file_hash") {
+		var geofences []*locationservice.BatchPutGeofenceRequestEntry
 
-	if !update {
-		return diags
-	}
+		if v, ok := d.GetOk("geofences_file"); ok {
+			var err error
+			geofences, err = readGeofencesFile(v.(string))
+			if err != nil {
+				return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofenceCollection, d.Id(), err)
+			}
+		}
 
-	log.Printf("[DEBUG] Updating Location GeofenceCollection (%s): %#v", d.Id(), in)
-	_, err := conn.UpdateGeofenceCollectionWithContext(ctx, in)
-	if err != nil {
-		return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofenceCollection, d.Id(), err)
+		if err := putGeofences(ctx, conn, d.Id(), geofences); err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofenceCollection, d.Id(), err)
+		}
+
+		// Remove any previously loaded geofences that are no longer in the file.
+		loaded := make(map[string]struct{}, len(geofences))
+		for _, v := range geofences {
+			loaded[aws.StringValue(v.GeofenceId)] = struct{}{}
+		}
+
+		var remove []string
+		for _, v := range flex.ExpandStringValueSet(d.Get("geofence_ids").(*schema.Set)) {
+			if _, ok := loaded[v]; !ok {
+				remove = append(remove, v)
+			}
+		}
+
+		if err := deleteGeofences(ctx, conn, d.Id(), remove); err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofenceCollection, d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceGeofenceCollectionRead(ctx, d, meta)...)
@@ -218,3 +281,151 @@ func findGeofenceCollectionByName(ctx context.Context, conn *locationservice.Loc
 
 	return out, nil
 }
+
+func findGeofenceIDsByCollectionName(ctx context.Context, conn *locationservice.LocationService, name string) ([]string, error) {
+	in := &locationservice.ListGeofencesInput{
+		CollectionName: aws.String(name),
+	}
+	var output []string
+
+	err := conn.ListGeofencesPagesWithContext(ctx, in, func(page *locationservice.ListGeofencesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entries {
+			switch aws.StringValue(v.Status) {
+			case geofenceStatusDeleted, geofenceStatusDeleting:
+				continue
+			}
+
+			output = append(output, aws.StringValue(v.GeofenceId))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+const (
+	// Maximum number of entries in a BatchPutGeofence or BatchDeleteGeofence request.
+	geofenceBatchMaxSize = 10
+
+	geofenceStatusDeleted  = "DELETED"
+	geofenceStatusDeleting = "DELETING"
+)
+
+func putGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, geofences []*locationservice.BatchPutGeofenceRequestEntry) error {
+	for _, chunk := range tfslices.Chunks(geofences, geofenceBatchMaxSize) {
+		out, err := conn.BatchPutGeofenceWithContext(ctx, &locationservice.BatchPutGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			Entries:        chunk,
+		})
+
+		if err != nil {
+			return fmt.Errorf("putting geofences: %w", err)
+		}
+
+		var errs []error
+		for _, v := range out.Errors {
+			errs = append(errs, fmt.Errorf("putting geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, geofenceIDs []string) error {
+	for _, chunk := range tfslices.Chunks(geofenceIDs, geofenceBatchMaxSize) {
+		out, err := conn.BatchDeleteGeofenceWithContext(ctx, &locationservice.BatchDeleteGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			GeofenceIds:    aws.StringSlice(chunk),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting geofences: %w", err)
+		}
+
+		var errs []error
+		for _, v := range out.Errors {
+			errs = append(errs, fmt.Errorf("deleting geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// geofencesFeatureCollection is the subset of a GeoJSON FeatureCollection needed to load geofences.
+type geofencesFeatureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		ID       string `json:"id"`
+		Geometry struct {
+			Type        string        `json:"type"`
+			Coordinates [][][]float64 `json:"coordinates"`
+		} `json:"geometry"`
+	} `json:"features"`
+}
+
+func readGeofencesFile(filename string) ([]*locationservice.BatchPutGeofenceRequestEntry, error) {
+	path, err := homedir.Expand(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading geofences file (%s): %w", filename, err)
+	}
+
+	var fc geofencesFeatureCollection
+	if err := json.Unmarshal(b, &fc); err != nil {
+		return nil, fmt.Errorf("parsing geofences file (%s): %w", filename, err)
+	}
+
+	if fc.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("geofences file (%s) must contain a GeoJSON FeatureCollection, got %q", filename, fc.Type)
+	}
+
+	var entries []*locationservice.BatchPutGeofenceRequestEntry
+	for i, feature := range fc.Features {
+		if feature.ID == "" {
+			return nil, fmt.Errorf("geofences file (%s): feature %d has no id", filename, i)
+		}
+
+		if feature.Geometry.Type != "Polygon" {
+			return nil, fmt.Errorf("geofences file (%s): feature (%s) geometry must be a Polygon, got %q", filename, feature.ID, feature.Geometry.Type)
+		}
+
+		polygon := make([][][]*float64, 0, len(feature.Geometry.Coordinates))
+		for _, ring := range feature.Geometry.Coordinates {
+			positions := make([][]*float64, 0, len(ring))
+			for _, position := range ring {
+				positions = append(positions, aws.Float64Slice(position))
+			}
+			polygon = append(polygon, positions)
+		}
+
+		entries = append(entries, &locationservice.BatchPutGeofenceRequestEntry{
+			GeofenceId: aws.String(feature.ID),
+			Geometry: &locationservice.GeofenceGeometry{
+				Polygon: polygon,
+			},
+		})
+	}
+
+	return entries, nil
+}
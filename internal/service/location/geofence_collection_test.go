@@ -112,6 +112,45 @@ func TestAccLocationGeofenceCollection_description(t *testing.T) {
 	})
 }
 
+func TestAccLocationGeofenceCollection_geofencesFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceCollectionConfig_geofencesFile(rName, "test-fixtures/geofences.geojson"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceCollectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "test-geofence-1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "test-geofence-2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"geofences_file", "geofences_file_hash"},
+			},
+			{
+				Config: testAccGeofenceCollectionConfig_geofencesFile(rName, "test-fixtures/geofences_updated.geojson"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceCollectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "test-geofence-2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "test-geofence-3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLocationGeofenceCollection_kmsKeyID(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -253,6 +292,16 @@ resource "aws_location_geofence_collection" "test" {
 `, rName, description)
 }
 
+func testAccGeofenceCollectionConfig_geofencesFile(rName, filename string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name     = %[1]q
+  geofences_file      = %[2]q
+  geofences_file_hash = filebase64sha256(%[2]q)
+}
+`, rName, filename)
+}
+
 func testAccGeofenceCollectionConfig_kmsKeyID(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Application Version")
+func newApplicationVersionDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &applicationVersionDataSource{}, nil
+}
+
+type applicationVersionDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*applicationVersionDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_m2_application_version"
+}
+
+func (d *applicationVersionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			"application_version": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"definition_content": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			"status_reason": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *applicationVersionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data applicationVersionDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().M2Client(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+	var version int32
+	if data.ApplicationVersion.IsNull() {
+		// Default to the application's latest version.
+		application, err := findApplicationByID(ctx, conn, applicationID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s)", applicationID), err.Error())
+
+			return
+		}
+
+		if application.LatestVersion == nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s)", applicationID), "application has no versions")
+
+			return
+		}
+
+		version = aws.ToInt32(application.LatestVersion.ApplicationVersion)
+	} else {
+		version = int32(data.ApplicationVersion.ValueInt64())
+	}
+
+	id := errs.Must(flex.FlattenResourceId([]string{applicationID, flex.Int32ValueToStringValue(version)}, applicationVersionDataSourceIDPartCount, false))
+	output, err := findApplicationVersionByTwoPartKey(ctx, conn, applicationID, version)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application Version (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ApplicationVersion = fwflex.Int32ToFramework(ctx, output.ApplicationVersion)
+	data.DefinitionContent = fwflex.StringToFramework(ctx, output.DefinitionContent)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Status = fwflex.StringValueToFramework(ctx, output.Status)
+	data.StatusReason = fwflex.StringToFramework(ctx, output.StatusReason)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+const (
+	applicationVersionDataSourceIDPartCount = 2
+)
+
+type applicationVersionDataSourceModel struct {
+	ApplicationID      types.String `tfsdk:"application_id"`
+	ApplicationVersion types.Int64  `tfsdk:"application_version"`
+	DefinitionContent  types.String `tfsdk:"definition_content"`
+	Description        types.String `tfsdk:"description"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Status             types.String `tfsdk:"status"`
+	StatusReason       types.String `tfsdk:"status_reason"`
+}
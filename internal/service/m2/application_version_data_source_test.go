@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2ApplicationVersionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_m2_application_version.test"
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationVersionDataSourceConfig_latest(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrApplicationID, resourceName, names.AttrApplicationID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_version", resourceName, "current_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "definition_content"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "Available"),
+				),
+			},
+			{
+				Config: testAccApplicationVersionDataSourceConfig_version(rName, 2, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "application_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "Available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationVersionDataSourceConfig_latest(rName string, version int) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_versioned(rName, "bluage", version, version), `
+data "aws_m2_application_version" "test" {
+  application_id = aws_m2_application.test.application_id
+
+  depends_on = [aws_m2_application.test]
+}
+`)
+}
+
+func testAccApplicationVersionDataSourceConfig_version(rName string, version, dataVersion int) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_versioned(rName, "bluage", version, version), fmt.Sprintf(`
+data "aws_m2_application_version" "test" {
+  application_id      = aws_m2_application.test.application_id
+  application_version = %[1]d
+}
+`, dataVersion))
+}
@@ -99,6 +99,16 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 
 	conn := r.Meta().M2Client(ctx)
 
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+
+	// Only an AVAILABLE application version can be deployed.
+	applicationID, applicationVersion := data.ApplicationID.ValueString(), int32(data.ApplicationVersion.ValueInt64())
+	if _, err := waitApplicationUpdated(ctx, conn, applicationID, applicationVersion, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Application (%s) version (%d) available", applicationID, applicationVersion), err.Error())
+
+		return
+	}
+
 	input := &m2.CreateDeploymentInput{}
 	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
 	if response.Diagnostics.HasError() {
@@ -120,7 +130,6 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 	data.DeploymentID = fwflex.StringToFramework(ctx, output.DeploymentId)
 	data.setID()
 
-	timeout := r.CreateTimeout(ctx, data.Timeouts)
 	if _, err := waitDeploymentCreated(ctx, conn, data.ApplicationID.ValueString(), data.DeploymentID.ValueString(), timeout); err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) create", data.ID.ValueString()), err.Error())
 
@@ -128,7 +137,6 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 	}
 
 	if data.Start.ValueBool() {
-		applicationID := data.ApplicationID.ValueString()
 		if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
 
@@ -214,6 +222,13 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 			}
 		}
 
+		applicationVersion := int32(new.ApplicationVersion.ValueInt64())
+		if _, err := waitApplicationUpdated(ctx, conn, applicationID, applicationVersion, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Application (%s) version (%d) available", applicationID, applicationVersion), err.Error())
+
+			return
+		}
+
 		input := &m2.CreateDeploymentInput{}
 		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
 		if response.Diagnostics.HasError() {
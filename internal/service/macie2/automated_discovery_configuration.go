@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_automated_discovery_configuration", name="Automated Discovery Configuration")
+func ResourceAutomatedDiscoveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		ReadWithoutTimeout:   resourceAutomatedDiscoveryConfigurationRead,
+		UpdateWithoutTimeout: resourceAutomatedDiscoveryConfigurationPut,
+		DeleteWithoutTimeout: resourceAutomatedDiscoveryConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"classification_scope_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"excluded_bucket_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sensitivity_inspection_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"included_allow_list_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"included_custom_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"included_managed_data_identifier_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"sensitivity_inspection_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.AutomatedDiscoveryStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAutomatedDiscoveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	if d.IsNewResource() || d.HasChange(names.AttrStatus) {
+		input := &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+			Status: aws.String(d.Get(names.AttrStatus).(string)),
+		}
+
+		_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie Automated Discovery Configuration: %s", err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	// On create, only update the classification scope and sensitivity inspection template if configured.
+	if _, ok := d.GetOk("excluded_bucket_names"); d.HasChange("excluded_bucket_names") && (ok || !d.IsNewResource()) {
+		id, err := findClassificationScopeID(ctx, conn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie Classification Scope: %s", err)
+		}
+
+		input := &macie2.UpdateClassificationScopeInput{
+			Id: aws.String(id),
+			S3: &macie2.S3ClassificationScopeUpdate{
+				Excludes: &macie2.S3ClassificationScopeExclusionUpdate{
+					BucketNames:   flex.ExpandStringSet(d.Get("excluded_bucket_names").(*schema.Set)),
+					OperationType: aws.String(macie2.ClassificationScopeUpdateOperationReplace),
+				},
+			},
+		}
+
+		_, err = conn.UpdateClassificationScopeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie Classification Scope (%s): %s", id, err)
+		}
+	}
+
+	if _, ok := d.GetOk("sensitivity_inspection_template"); d.HasChange("sensitivity_inspection_template") && (ok || !d.IsNewResource()) {
+		id, err := findSensitivityInspectionTemplateID(ctx, conn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie Sensitivity Inspection Template: %s", err)
+		}
+
+		input := expandUpdateSensitivityInspectionTemplateInput(d.Get("sensitivity_inspection_template").([]interface{}))
+		input.Id = aws.String(id)
+
+		_, err = conn.UpdateSensitivityInspectionTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Macie Sensitivity Inspection Template (%s): %s", id, err)
+		}
+	}
+
+	return append(diags, resourceAutomatedDiscoveryConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceAutomatedDiscoveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := findAutomatedDiscoveryConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Automated Discovery Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("classification_scope_id", output.ClassificationScopeId)
+	d.Set("sensitivity_inspection_template_id", output.SensitivityInspectionTemplateId)
+	d.Set(names.AttrStatus, output.Status)
+
+	if id := aws.StringValue(output.ClassificationScopeId); id != "" {
+		scope, err := conn.GetClassificationScopeWithContext(ctx, &macie2.GetClassificationScopeInput{
+			Id: aws.String(id),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie Classification Scope (%s): %s", id, err)
+		}
+
+		var bucketNames []*string
+		if scope.S3 != nil && scope.S3.Excludes != nil {
+			bucketNames = scope.S3.Excludes.BucketNames
+		}
+		d.Set("excluded_bucket_names", aws.StringValueSlice(bucketNames))
+	}
+
+	if id := aws.StringValue(output.SensitivityInspectionTemplateId); id != "" {
+		template, err := conn.GetSensitivityInspectionTemplateWithContext(ctx, &macie2.GetSensitivityInspectionTemplateInput{
+			Id: aws.String(id),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie Sensitivity Inspection Template (%s): %s", id, err)
+		}
+
+		if err := d.Set("sensitivity_inspection_template", flattenSensitivityInspectionTemplate(template)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting sensitivity_inspection_template: %s", err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAutomatedDiscoveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	log.Printf("[DEBUG] Disabling Macie Automated Discovery Configuration: %s", d.Id())
+	_, err := conn.UpdateAutomatedDiscoveryConfigurationWithContext(ctx, &macie2.UpdateAutomatedDiscoveryConfigurationInput{
+		Status: aws.String(macie2.AutomatedDiscoveryStatusDisabled),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling Macie Automated Discovery Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAutomatedDiscoveryConfiguration(ctx context.Context, conn *macie2.Macie2) (*macie2.GetAutomatedDiscoveryConfigurationOutput, error) {
+	input := &macie2.GetAutomatedDiscoveryConfigurationInput{}
+
+	output, err := conn.GetAutomatedDiscoveryConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findClassificationScopeID returns the ID of the account's classification scope.
+// Each account has exactly one classification scope for automated sensitive data discovery.
+func findClassificationScopeID(ctx context.Context, conn *macie2.Macie2) (string, error) {
+	input := &macie2.ListClassificationScopesInput{}
+	var output []*macie2.ClassificationScopeSummary
+
+	err := conn.ListClassificationScopesPagesWithContext(ctx, input, func(page *macie2.ListClassificationScopesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.ClassificationScopes...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	v, err := tfresource.AssertSinglePtrResult(output)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(v.Id), nil
+}
+
+// findSensitivityInspectionTemplateID returns the ID of the account's sensitivity inspection template.
+// Each account has exactly one sensitivity inspection template for automated sensitive data discovery.
+func findSensitivityInspectionTemplateID(ctx context.Context, conn *macie2.Macie2) (string, error) {
+	input := &macie2.ListSensitivityInspectionTemplatesInput{}
+	var output []*macie2.SensitivityInspectionTemplatesEntry
+
+	err := conn.ListSensitivityInspectionTemplatesPagesWithContext(ctx, input, func(page *macie2.ListSensitivityInspectionTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.SensitivityInspectionTemplates...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	v, err := tfresource.AssertSinglePtrResult(output)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(v.Id), nil
+}
+
+func expandUpdateSensitivityInspectionTemplateInput(tfList []interface{}) *macie2.UpdateSensitivityInspectionTemplateInput {
+	apiObject := &macie2.UpdateSensitivityInspectionTemplateInput{
+		Excludes: &macie2.SensitivityInspectionTemplateExcludes{},
+		Includes: &macie2.SensitivityInspectionTemplateIncludes{},
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["excluded_managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Excludes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_allow_list_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Includes.AllowListIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_custom_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Includes.CustomDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["included_managed_data_identifier_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Includes.ManagedDataIdentifierIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSensitivityInspectionTemplate(apiObject *macie2.GetSensitivityInspectionTemplateOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Excludes; v != nil {
+		tfMap["excluded_managed_data_identifier_ids"] = aws.StringValueSlice(v.ManagedDataIdentifierIds)
+	}
+
+	if v := apiObject.Includes; v != nil {
+		tfMap["included_allow_list_ids"] = aws.StringValueSlice(v.AllowListIds)
+		tfMap["included_custom_data_identifier_ids"] = aws.StringValueSlice(v.CustomDataIdentifierIds)
+		tfMap["included_managed_data_identifier_ids"] = aws.StringValueSlice(v.ManagedDataIdentifierIds)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -39,6 +39,13 @@ func ResourceClassificationJob() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"allow_list_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"custom_data_identifier_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -46,6 +53,20 @@ func ResourceClassificationJob() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"managed_data_identifier_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"managed_data_identifier_selector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(macie2.ManagedDataIdentifierSelector_Values(), false),
+			},
 			"schedule_frequency": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -590,9 +611,18 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("allow_list_ids"); ok {
+		input.AllowListIds = flex.ExpandStringList(v.([]interface{}))
+	}
 	if v, ok := d.GetOk("custom_data_identifier_ids"); ok {
 		input.CustomDataIdentifierIds = flex.ExpandStringList(v.([]interface{}))
 	}
+	if v, ok := d.GetOk("managed_data_identifier_ids"); ok {
+		input.ManagedDataIdentifierIds = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("managed_data_identifier_selector"); ok {
+		input.ManagedDataIdentifierSelector = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("schedule_frequency"); ok {
 		input.ScheduleFrequency = expandScheduleFrequency(v.([]interface{}))
 	}
@@ -657,9 +687,16 @@ func resourceClassificationJobRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading Macie ClassificationJob (%s): %s", d.Id(), err)
 	}
 
+	if err = d.Set("allow_list_ids", flex.FlattenStringList(resp.AllowListIds)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "allow_list_ids", d.Id(), err)
+	}
 	if err = d.Set("custom_data_identifier_ids", flex.FlattenStringList(resp.CustomDataIdentifierIds)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "custom_data_identifier_ids", d.Id(), err)
 	}
+	if err = d.Set("managed_data_identifier_ids", flex.FlattenStringList(resp.ManagedDataIdentifierIds)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "managed_data_identifier_ids", d.Id(), err)
+	}
+	d.Set("managed_data_identifier_selector", resp.ManagedDataIdentifierSelector)
 	if err = d.Set("schedule_frequency", flattenScheduleFrequency(resp.ScheduleFrequency)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "schedule_frequency", d.Id(), err)
 	}
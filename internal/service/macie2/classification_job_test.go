@@ -391,6 +391,44 @@ func testAccClassificationJob_BucketCriteria(t *testing.T) {
 	})
 }
 
+func testAccClassificationJob_managedDataIdentifiers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationJobConfig_managedDataIdentifiers(bucketName, macie2.ManagedDataIdentifierSelectorInclude),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.0", "CREDIT_CARD_NUMBER"),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_selector", macie2.ManagedDataIdentifierSelectorInclude),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClassificationJobConfig_managedDataIdentifiers(bucketName, macie2.ManagedDataIdentifierSelectorExclude),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_selector", macie2.ManagedDataIdentifierSelectorExclude),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClassificationJobExists(ctx context.Context, resourceName string, macie2Session *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -480,6 +518,31 @@ resource "aws_macie2_classification_job" "test" {
 `, bucketName, jobType)
 }
 
+func testAccClassificationJobConfig_managedDataIdentifiers(bucketName, selector string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_classification_job" "test" {
+  depends_on = [aws_macie2_account.test]
+  job_type   = "ONE_TIME"
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+  managed_data_identifier_ids      = ["CREDIT_CARD_NUMBER"]
+  managed_data_identifier_selector = %[2]q
+}
+`, bucketName, selector)
+}
+
 func testAccClassificationJobConfig_namePrefix(nameBucket, namePrefix, jobType string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -20,18 +20,22 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			acctest.CtDisappears:           testAccAccount_disappears,
 		},
+		"AutomatedDiscoveryConfiguration": {
+			acctest.CtBasic: testAccAutomatedDiscoveryConfiguration_basic,
+		},
 		"ClassificationExportConfiguration": {
 			acctest.CtBasic: testAccClassificationExportConfiguration_basic,
 		},
 		"ClassificationJob": {
-			acctest.CtBasic:      testAccClassificationJob_basic,
-			"name_generated":     testAccClassificationJob_Name_Generated,
-			"name_prefix":        testAccClassificationJob_NamePrefix,
-			acctest.CtDisappears: testAccClassificationJob_disappears,
-			"status":             testAccClassificationJob_Status,
-			"complete":           testAccClassificationJob_complete,
-			"tags":               testAccClassificationJob_WithTags,
-			"bucket_criteria":    testAccClassificationJob_BucketCriteria,
+			acctest.CtBasic:            testAccClassificationJob_basic,
+			"name_generated":           testAccClassificationJob_Name_Generated,
+			"name_prefix":              testAccClassificationJob_NamePrefix,
+			acctest.CtDisappears:       testAccClassificationJob_disappears,
+			"status":                   testAccClassificationJob_Status,
+			"complete":                 testAccClassificationJob_complete,
+			"tags":                     testAccClassificationJob_WithTags,
+			"bucket_criteria":          testAccClassificationJob_BucketCriteria,
+			"managed_data_identifiers": testAccClassificationJob_managedDataIdentifiers,
 		},
 		"CustomDataIdentifier": {
 			acctest.CtBasic:      testAccCustomDataIdentifier_basic,
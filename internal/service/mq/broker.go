@@ -146,7 +146,7 @@ func resourceBroker() *schema.Resource {
 							Optional:     true,
 							Computed:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidKMSKeyOrAliasARN,
 						},
 						"use_aws_owned_key": {
 							Type:     schema.TypeBool,
@@ -166,10 +166,30 @@ func resourceBroker() *schema.Resource {
 			names.AttrEngineVersion: {
 				Type:     schema.TypeString,
 				Required: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// With automatic minor version upgrades enabled the configured
+					// version may be specified as major.minor only (required for
+					// RabbitMQ 3.13 and later), while the broker reports the full
+					// version, e.g. "3.13" and "3.13.7".
+					if d.Get(names.AttrAutoMinorVersionUpgrade).(bool) && n != "" && strings.HasPrefix(o, n+".") {
+						return true
+					}
+					return false
+				},
 			},
 			"host_instance_type": {
 				Type:     schema.TypeString,
 				Required: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// Suppress differences when the configured instance type matches
+					// a non-empty, pending instance type. This scenario can exist when
+					// the instance type has been changed, but the broker has not yet
+					// been rebooted, e.g. during the next maintenance window.
+					if n != "" && n == d.Get("pending_host_instance_type").(string) {
+						return true
+					}
+					return false
+				},
 			},
 			"instances": {
 				Type:     schema.TypeList,
@@ -295,6 +315,10 @@ func resourceBroker() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pending_host_instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrPubliclyAccessible: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -305,7 +329,10 @@ func resourceBroker() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				MaxItems: 5,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidSecurityGroupID,
+				},
 			},
 			names.AttrStorageType: {
 				Type:             schema.TypeString,
@@ -314,8 +341,11 @@ func resourceBroker() *schema.Resource {
 				ValidateDiagFunc: enum.ValidateIgnoreCase[types.BrokerStorageType](),
 			},
 			names.AttrSubnetIDs: {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidSubnetID,
+				},
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
@@ -384,6 +414,17 @@ func resourceBroker() *schema.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if strings.EqualFold(diff.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) {
+					if engineVersion := diff.Get(names.AttrEngineVersion).(string); rabbitMQVersionRequiresAutoMinorVersionUpgrade(engineVersion) {
+						if !diff.Get(names.AttrAutoMinorVersionUpgrade).(bool) {
+							return fmt.Errorf("auto_minor_version_upgrade: Must be true when engine is RabbitMQ %s", engineVersion)
+						}
+					}
+				}
+
 				return nil
 			},
 		),
@@ -490,6 +531,7 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("host_instance_type", output.HostInstanceType)
 	d.Set("instances", flattenBrokerInstances(output.BrokerInstances))
 	d.Set("pending_data_replication_mode", output.PendingDataReplicationMode)
+	d.Set("pending_host_instance_type", output.PendingHostInstanceType)
 	d.Set(names.AttrPubliclyAccessible, output.PubliclyAccessible)
 	d.Set(names.AttrSecurityGroups, output.SecurityGroups)
 	d.Set(names.AttrStorageType, output.StorageType)
@@ -781,6 +823,28 @@ func waitBrokerRebooted(ctx context.Context, conn *mq.Client, id string, timeout
 	return nil, err
 }
 
+// rabbitMQVersionRequiresAutoMinorVersionUpgrade returns whether the specified
+// RabbitMQ engine version (3.13 and later) can only be used with automatic
+// minor version upgrades enabled.
+func rabbitMQVersionRequiresAutoMinorVersionUpgrade(engineVersion string) bool {
+	parts := strings.Split(engineVersion, ".")
+	if len(parts) < 2 {
+		return false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false
+	}
+
+	return major > 3 || (major == 3 && minor >= 13)
+}
+
 func resourceUserHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1259,6 +1259,42 @@ func TestAccMQBroker_RabbitMQ_validationAuditLog(t *testing.T) {
 	})
 }
 
+func TestAccMQBroker_RabbitMQ_validationAutoMinorVersionUpgrade(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var broker mq.DescribeBrokerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrokerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBrokerConfig_rabbitAutoMinorVersionUpgrade(rName, "3.13", false),
+				ExpectError: regexache.MustCompile(`auto_minor_version_upgrade: Must be true when engine is RabbitMQ 3.13`),
+			},
+			{
+				Config: testAccBrokerConfig_rabbitAutoMinorVersionUpgrade(rName, "3.13", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(ctx, resourceName, &broker),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAutoMinorVersionUpgrade, acctest.CtTrue),
+					resource.TestMatchResourceAttr(resourceName, names.AttrEngineVersion, regexache.MustCompile(`^3\.13`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMQBroker_RabbitMQ_cluster(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2248,6 +2284,32 @@ resource "aws_security_group" "test" {
 `, rName, version, enabled)
 }
 
+func testAccBrokerConfig_rabbitAutoMinorVersionUpgrade(rName, version string, autoMinorVersionUpgrade bool) string {
+	return fmt.Sprintf(`
+resource "aws_mq_broker" "test" {
+  broker_name                = %[1]q
+  engine_type                = "RabbitMQ"
+  engine_version             = %[2]q
+  host_instance_type         = "mq.t3.micro"
+  security_groups            = [aws_security_group.test.id]
+  auto_minor_version_upgrade = %[3]t
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, version, autoMinorVersionUpgrade)
+}
+
 func testAccBrokerConfig_rabbitCluster(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
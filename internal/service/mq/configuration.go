@@ -6,8 +6,13 @@ package mq
 import (
 	"context"
 	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
@@ -55,6 +60,26 @@ func resourceConfiguration() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if !diff.NewValueKnown("data") {
+					return nil
+				}
+
+				data := diff.Get("data").(string)
+
+				switch engineType := diff.Get("engine_type").(string); {
+				case strings.EqualFold(engineType, string(types.EngineTypeActivemq)):
+					if err := validActiveMQConfig(data); err != nil {
+						return fmt.Errorf("data: invalid ActiveMQ XML configuration: %w", err)
+					}
+				case strings.EqualFold(engineType, string(types.EngineTypeRabbitmq)):
+					if err := validCuttlefishConfig(data); err != nil {
+						return fmt.Errorf("data: invalid RabbitMQ Cuttlefish configuration: %w", err)
+					}
+				}
+
+				return nil
+			},
 			verify.SetTagsDiff,
 		),
 
@@ -248,6 +273,54 @@ func findConfigurationByID(ctx context.Context, conn *mq.Client, id string) (*mq
 	return output, nil
 }
 
+// validActiveMQConfig checks that an ActiveMQ configuration is a well-formed
+// XML document with a root element.
+func validActiveMQConfig(s string) error {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	hasRoot := false
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := token.(xml.StartElement); ok {
+			hasRoot = true
+		}
+	}
+
+	if !hasRoot {
+		return errors.New("no root element")
+	}
+
+	return nil
+}
+
+// validCuttlefishConfig checks that every non-empty, non-comment line of a
+// RabbitMQ configuration is a "key = value" pair.
+func validCuttlefishConfig(s string) error {
+	for i, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(value) == "" {
+			return fmt.Errorf("line %d: expected \"key = value\", got %q", i+1, line)
+		}
+	}
+
+	return nil
+}
+
 func suppressXMLEquivalentConfig(k, old, new string, d *schema.ResourceData) bool {
 	os, err := CanonicalXML(old)
 	if err != nil {
@@ -177,6 +177,32 @@ func TestAccMQConfiguration_withRabbitMQData(t *testing.T) {
 	})
 }
 
+func TestAccMQConfiguration_invalidData(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MQEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MQServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigurationConfig_invalidActiveMQData(rName),
+				ExpectError: regexache.MustCompile(`invalid ActiveMQ XML configuration`),
+			},
+			{
+				Config:      testAccConfigurationConfig_invalidRabbitData(rName),
+				ExpectError: regexache.MustCompile(`invalid RabbitMQ Cuttlefish configuration`),
+			},
+		},
+	})
+}
+
 func TestAccMQConfiguration_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -354,6 +380,36 @@ DATA
 `, rName)
 }
 
+func testAccConfigurationConfig_invalidActiveMQData(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_configuration" "test" {
+  description    = "TfAccTest MQ Configuration"
+  name           = %[1]q
+  engine_type    = "ActiveMQ"
+  engine_version = "5.17.6"
+
+  data = <<DATA
+<broker xmlns="http://activemq.apache.org/schema/core">
+DATA
+}
+`, rName)
+}
+
+func testAccConfigurationConfig_invalidRabbitData(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mq_configuration" "test" {
+  description    = "TfAccTest MQ Configuration"
+  name           = %[1]q
+  engine_type    = "RabbitMQ"
+  engine_version = "3.11.16"
+
+  data = <<DATA
+consumer_timeout
+DATA
+}
+`, rName)
+}
+
 func testAccConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_mq_configuration" "test" {
@@ -5,13 +5,17 @@ package networkfirewall
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -43,6 +47,32 @@ func resourceRuleGroup() *schema.Resource {
 
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
+				"analysis_results": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"analysis_detail": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"identified_rule_ids": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"identified_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"analyze_rule_group": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				names.AttrARN: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -435,8 +465,35 @@ func resourceRuleGroup() *schema.Resource {
 					},
 				},
 				"rules": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"rules_source_s3"},
+				},
+				"rules_source_s3": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"rules"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrBucket: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							names.AttrKey: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"version_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"rules_source_s3_etag": {
 					Type:     schema.TypeString,
-					Optional: true,
+					Computed: true,
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -458,6 +515,7 @@ func resourceRuleGroup() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("rule_group.0.stateful_rule_options.0.rule_order", d)
 			},
+			resourceRuleGroupRulesSourceS3CustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -491,6 +549,21 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Rules = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("rules_source_s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		rules, etag, err := findRulesStringFromS3(ctx, meta.(*conns.AWSClient).S3Client(ctx), v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating NetworkFirewall Rule Group (%s): %s", name, err)
+		}
+
+		input.Rules = aws.String(rules)
+		d.Set("rules_source_s3_etag", etag)
+	}
+
+	if d.Get("analyze_rule_group").(bool) {
+		input.AnalyzeRuleGroup = aws.Bool(true)
+	}
+
 	output, err := conn.CreateRuleGroup(ctx, input)
 
 	if err != nil {
@@ -499,6 +572,8 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(aws.ToString(output.RuleGroupResponse.RuleGroupArn))
 
+	diags = append(diags, analysisResultsDiags(d.Id(), output.RuleGroupResponse.AnalysisResults)...)
+
 	return append(diags, resourceRuleGroupRead(ctx, d, meta)...)
 }
 
@@ -506,7 +581,15 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
 
-	output, err := findRuleGroupByARN(ctx, conn, d.Id())
+	input := &networkfirewall.DescribeRuleGroupInput{
+		RuleGroupArn: aws.String(d.Id()),
+	}
+
+	if d.Get("analyze_rule_group").(bool) {
+		input.AnalyzeRuleGroup = aws.Bool(true)
+	}
+
+	output, err := findRuleGroup(ctx, conn, input)
 
 	if err == nil && output.RuleGroup == nil {
 		err = tfresource.NewEmptyResultError(d.Id())
@@ -523,6 +606,9 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	response := output.RuleGroupResponse
+	if err := d.Set("analysis_results", flattenAnalysisResults(response.AnalysisResults)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_results: %s", err)
+	}
 	d.Set(names.AttrARN, response.RuleGroupArn)
 	d.Set("capacity", response.Capacity)
 	d.Set(names.AttrDescription, response.Description)
@@ -545,7 +631,7 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
 
-	if d.HasChanges(names.AttrDescription, names.AttrEncryptionConfiguration, "rule_group", "rules", names.AttrType) {
+	if d.HasChanges(names.AttrDescription, names.AttrEncryptionConfiguration, "rule_group", "rules", "rules_source_s3", "rules_source_s3_etag", names.AttrType) {
 		input := &networkfirewall.UpdateRuleGroupInput{
 			EncryptionConfiguration: expandEncryptionConfiguration(d.Get(names.AttrEncryptionConfiguration).([]interface{})),
 			RuleGroupArn:            aws.String(d.Id()),
@@ -563,6 +649,17 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19414
 		if d.HasChange("rules") {
 			input.Rules = aws.String(d.Get("rules").(string))
+		} else if d.HasChanges("rules_source_s3", "rules_source_s3_etag") {
+			if v, ok := d.GetOk("rules_source_s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				rules, etag, err := findRulesStringFromS3(ctx, meta.(*conns.AWSClient).S3Client(ctx), v.([]interface{})[0].(map[string]interface{}))
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
+				}
+
+				input.Rules = aws.String(rules)
+				d.Set("rules_source_s3_etag", etag)
+			}
 		} else if d.HasChange("rule_group") {
 			if v, ok := d.GetOk("rule_group"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.RuleGroup = expandRuleGroup(v.([]interface{})[0].(map[string]interface{}))
@@ -580,11 +677,17 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			}
 		}
 
-		_, err := conn.UpdateRuleGroup(ctx, input)
+		if d.Get("analyze_rule_group").(bool) {
+			input.AnalyzeRuleGroup = aws.Bool(true)
+		}
+
+		output, err := conn.UpdateRuleGroup(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
 		}
+
+		diags = append(diags, analysisResultsDiags(d.Id(), output.RuleGroupResponse.AnalysisResults)...)
 	}
 
 	return append(diags, resourceRuleGroupRead(ctx, d, meta)...)
@@ -624,6 +727,10 @@ func findRuleGroupByARN(ctx context.Context, conn *networkfirewall.Client, arn s
 		RuleGroupArn: aws.String(arn),
 	}
 
+	return findRuleGroup(ctx, conn, input)
+}
+
+func findRuleGroup(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.DescribeRuleGroupInput) (*networkfirewall.DescribeRuleGroupOutput, error) {
 	output, err := conn.DescribeRuleGroup(ctx, input)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
@@ -677,6 +784,107 @@ func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.Client, arn
 	return nil, err
 }
 
+// findRulesStringFromS3 returns the Suricata compatible rules stored in the specified S3 object along with the object's ETag.
+func findRulesStringFromS3(ctx context.Context, conn *s3.Client, tfMap map[string]interface{}) (string, string, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(tfMap[names.AttrBucket].(string)),
+		Key:    aws.String(tfMap[names.AttrKey].(string)),
+	}
+
+	if v, ok := tfMap["version_id"].(string); ok && v != "" {
+		input.VersionId = aws.String(v)
+	}
+
+	output, err := conn.GetObject(ctx, input)
+
+	if err != nil {
+		return "", "", fmt.Errorf("reading S3 object (s3://%s/%s): %w", aws.ToString(input.Bucket), aws.ToString(input.Key), err)
+	}
+
+	defer output.Body.Close()
+
+	body, err := io.ReadAll(output.Body)
+
+	if err != nil {
+		return "", "", fmt.Errorf("reading S3 object (s3://%s/%s) body: %w", aws.ToString(input.Bucket), aws.ToString(input.Key), err)
+	}
+
+	return string(body), aws.ToString(output.ETag), nil
+}
+
+// resourceRuleGroupRulesSourceS3CustomizeDiff compares the ETag of the rules_source_s3 object with the
+// value recorded at the last apply so that changes to the object's content trigger an update.
+func resourceRuleGroupRulesSourceS3CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Source location is unknown (e.g. references a resource not yet created).
+	if !d.NewValueKnown("rules_source_s3") {
+		return d.SetNewComputed("rules_source_s3_etag")
+	}
+
+	v, ok := d.GetOk("rules_source_s3")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	if d.Id() == "" || d.HasChange("rules_source_s3") {
+		return d.SetNewComputed("rules_source_s3_etag")
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(tfMap[names.AttrBucket].(string)),
+		Key:    aws.String(tfMap[names.AttrKey].(string)),
+	}
+
+	if v, ok := tfMap["version_id"].(string); ok && v != "" {
+		input.VersionId = aws.String(v)
+	}
+
+	output, err := meta.(*conns.AWSClient).S3Client(ctx).HeadObject(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("reading S3 object (s3://%s/%s): %w", aws.ToString(input.Bucket), aws.ToString(input.Key), err)
+	}
+
+	if etag := aws.ToString(output.ETag); etag != d.Get("rules_source_s3_etag").(string) {
+		return d.SetNew("rules_source_s3_etag", etag)
+	}
+
+	return nil
+}
+
+// analysisResultsDiags returns a warning for each rule that the rule group analysis identified as problematic.
+func analysisResultsDiags(id string, apiObjects []awstypes.AnalysisResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, apiObject := range apiObjects {
+		diags = append(diags, errs.NewWarningDiagnostic(
+			fmt.Sprintf("NetworkFirewall Rule Group (%s) analysis: %s", id, apiObject.IdentifiedType),
+			fmt.Sprintf("Rule IDs %s: %s", strings.Join(apiObject.IdentifiedRuleIds, ", "), aws.ToString(apiObject.AnalysisDetail)),
+		))
+	}
+
+	return diags
+}
+
+func flattenAnalysisResults(apiObjects []awstypes.AnalysisResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"analysis_detail":     aws.ToString(apiObject.AnalysisDetail),
+			"identified_rule_ids": apiObject.IdentifiedRuleIds,
+			"identified_type":     string(apiObject.IdentifiedType),
+		})
+	}
+
+	return tfList
+}
+
 func expandStatefulRuleHeader(tfList []interface{}) *awstypes.Header {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
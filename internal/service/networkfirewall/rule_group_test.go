@@ -288,6 +288,80 @@ alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_rulesSourceS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	rules := `alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)`
+	updatedRules := `alert http any any -> any any (http_response_line; content:"404 Not Found"; sid:1;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_rulesSourceS3(rName, rules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "rules_source_s3.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "rules_source_s3_etag", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rules_source_s3", "rules_source_s3_etag"},
+			},
+			{
+				Config: testAccRuleGroupConfig_rulesSourceS3(rName, updatedRules),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "rules_source_s3_etag", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", updatedRules),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_analyzeRuleGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_analyzeRuleGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "analyze_rule_group", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "analysis_results.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "analysis_results.0.identified_rule_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "analysis_results.0.identified_type"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"analysis_results", "analyze_rule_group"},
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_statefulRuleOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -1599,6 +1673,67 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules)
 }
 
+func testAccRuleGroupConfig_rulesSourceS3(rName, rules string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "suricata.rules"
+  content = %[2]q
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rules_source_s3 {
+    bucket = aws_s3_object.test.bucket
+    key    = aws_s3_object.test.key
+  }
+}
+`, rName, rules)
+}
+
+func testAccRuleGroupConfig_analyzeRuleGroup(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  analyze_rule_group = true
+  capacity           = 100
+  name               = %[1]q
+  type               = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:pass"]
+
+            match_attributes {
+              destination {
+                address_definition = "0.0.0.0/0"
+              }
+
+              source {
+                address_definition = "0.0.0.0/0"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccRuleGroupConfig_sourceString(rName, rules string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
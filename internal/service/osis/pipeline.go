@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -32,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -99,6 +101,16 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 					stringvalidator.LengthBetween(3, 28),
 				},
 			},
+			names.AttrStatus: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.PipelineStatusActive, awstypes.PipelineStatusStopped)...),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -126,6 +138,9 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 						names.AttrKMSKeyARN: schema.StringAttribute{
 							CustomType: fwtypes.ARNType,
 							Required:   true,
+							Validators: []validator.String{
+								fwvalidators.KMSKeyARN(),
+							},
 						},
 					},
 				},
@@ -185,6 +200,7 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 							},
 							Validators: []validator.Set{
 								setvalidator.SizeBetween(1, 12),
+								setvalidator.ValueStringsAre(fwvalidators.SecurityGroupID()),
 							},
 						},
 						names.AttrSubnetIDs: schema.SetAttribute{
@@ -196,6 +212,7 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 							},
 							Validators: []validator.Set{
 								setvalidator.SizeBetween(1, 12),
+								setvalidator.ValueStringsAre(fwvalidators.SubnetID()),
 							},
 						},
 					},
@@ -245,9 +262,20 @@ func (r *pipelineResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	if data.Status.ValueString() == string(awstypes.PipelineStatusStopped) {
+		pipeline, err = stopPipeline(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+			return
+		}
+	}
+
 	// Set values for unknowns.
 	data.IngestEndpointUrls.SetValue = fwflex.FlattenFrameworkStringValueSet(ctx, pipeline.IngestEndpointUrls)
 	data.PipelineARN = fwflex.StringToFramework(ctx, pipeline.PipelineArn)
+	data.Status = fwflex.StringValueToFramework(ctx, pipeline.Status)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -304,6 +332,8 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 
 	conn := r.Meta().OpenSearchIngestionClient(ctx)
 
+	name := new.PipelineName.ValueString()
+
 	if !new.BufferOptions.Equal(old.BufferOptions) ||
 		!new.EncryptionAtRestOptions.Equal(old.EncryptionAtRestOptions) ||
 		!new.LogPublishingOptions.Equal(old.LogPublishingOptions) ||
@@ -316,7 +346,6 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 			return
 		}
 
-		name := new.PipelineName.ValueString()
 		_, err := conn.UpdatePipeline(ctx, input)
 
 		if err != nil {
@@ -332,6 +361,27 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 		}
 	}
 
+	if !new.Status.IsUnknown() && !new.Status.Equal(old.Status) {
+		switch awstypes.PipelineStatus(new.Status.ValueString()) {
+		case awstypes.PipelineStatusActive:
+			if _, err := startPipeline(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("starting OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+				return
+			}
+		case awstypes.PipelineStatusStopped:
+			if _, err := stopPipeline(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("stopping OpenSearch Ingestion Pipeline (%s)", name), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if new.Status.IsUnknown() {
+		new.Status = old.Status
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -369,9 +419,88 @@ func (r *pipelineResource) Delete(ctx context.Context, request resource.DeleteRe
 }
 
 func (r *pipelineResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.Plan.Raw.IsNull() && r.Meta() != nil {
+		var plan pipelineResourceModel
+		response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var pipelineConfigurationBody types.String
+		if !request.State.Raw.IsNull() {
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("pipeline_configuration_body"), &pipelineConfigurationBody)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Validate the pipeline configuration so that errors surface at plan time rather than during apply.
+		if v := plan.PipelineConfigurationBody; !v.IsUnknown() && !v.IsNull() && !v.Equal(pipelineConfigurationBody) {
+			conn := r.Meta().OpenSearchIngestionClient(ctx)
+
+			output, err := validatePipelineConfiguration(ctx, conn, v.ValueString())
+
+			if err != nil {
+				response.Diagnostics.AddError("validating OpenSearch Ingestion Pipeline configuration", err.Error())
+
+				return
+			}
+
+			if !aws.ToBool(output.IsValid) {
+				for _, v := range output.Errors {
+					response.Diagnostics.AddAttributeError(path.Root("pipeline_configuration_body"), "invalid OpenSearch Ingestion Pipeline configuration", aws.ToString(v.Message))
+				}
+
+				return
+			}
+		}
+	}
+
 	r.SetTagsAll(ctx, request, response)
 }
 
+func validatePipelineConfiguration(ctx context.Context, conn *osis.Client, body string) (*osis.ValidatePipelineOutput, error) {
+	input := &osis.ValidatePipelineInput{
+		PipelineConfigurationBody: aws.String(body),
+	}
+
+	output, err := conn.ValidatePipeline(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func startPipeline(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	input := &osis.StartPipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	if _, err := conn.StartPipeline(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return waitPipelineStarted(ctx, conn, name, timeout)
+}
+
+func stopPipeline(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	input := &osis.StopPipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	if _, err := conn.StopPipeline(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return waitPipelineStopped(ctx, conn, name, timeout)
+}
+
 func findPipelineByName(ctx context.Context, conn *osis.Client, name string) (*awstypes.Pipeline, error) {
 	input := &osis.GetPipelineInput{
 		PipelineName: aws.String(name),
@@ -459,6 +588,52 @@ func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, ti
 	return nil, err
 }
 
+func waitPipelineStarted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PipelineStatusStarting, awstypes.PipelineStatusStopped),
+		Target:     enum.Slice(awstypes.PipelineStatusActive),
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipelineStopped(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PipelineStatusActive, awstypes.PipelineStatusStopping),
+		Target:     enum.Slice(awstypes.PipelineStatusStopped),
+		Refresh:    statusPipeline(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Pipeline); ok {
+		if reason := output.StatusReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(reason.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitPipelineDeleted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.PipelineStatusDeleting),
@@ -493,6 +668,7 @@ type pipelineResourceModel struct {
 	PipelineARN               types.String                                                  `tfsdk:"pipeline_arn"`
 	PipelineConfigurationBody types.String                                                  `tfsdk:"pipeline_configuration_body"`
 	PipelineName              types.String                                                  `tfsdk:"pipeline_name"`
+	Status                    types.String                                                  `tfsdk:"status"`
 	Tags                      types.Map                                                     `tfsdk:"tags"`
 	TagsAll                   types.Map                                                     `tfsdk:"tags_all"`
 	Timeouts                  timeouts.Value                                                `tfsdk:"timeouts"`
@@ -50,6 +50,7 @@ func TestAccOpenSearchIngestionPipeline_basic(t *testing.T) {
 					acctest.MatchResourceAttrRegionalARN(resourceName, "pipeline_arn", "osis", regexache.MustCompile(`pipeline/.+$`)),
 					resource.TestCheckResourceAttrSet(resourceName, "pipeline_configuration_body"),
 					resource.TestCheckResourceAttr(resourceName, "pipeline_name", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.PipelineStatusActive)),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", acctest.Ct0),
 				),
@@ -242,6 +243,67 @@ func TestAccOpenSearchIngestionPipeline_vpc(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchIngestionPipeline_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pipeline types.Pipeline
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchIngestionEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_status(rName, string(types.PipelineStatusStopped)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.PipelineStatusStopped)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_status(rName, string(types.PipelineStatusActive)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.PipelineStatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchIngestionPipeline_invalidConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchIngestionEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineConfig_invalidConfiguration(rName),
+				ExpectError: regexache.MustCompile(`invalid OpenSearch Ingestion Pipeline configuration`),
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchIngestionPipeline_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pipeline types.Pipeline
@@ -395,6 +457,71 @@ resource "aws_osis_pipeline" "test" {
 `, rName)
 }
 
+func testAccPipelineConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Effect = "Allow"
+        Sid    = ""
+        Principal = {
+          Service = "osis-pipelines.amazonaws.com"
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+            version: "2"
+            test-pipeline:
+              source:
+                http:
+                  path: "/test"
+              sink:
+                - s3:
+                    aws:
+                      sts_role_arn: "${aws_iam_role.test.arn}"
+                      region: "${data.aws_region.current.name}"
+                    bucket: "test"
+                    threshold:
+                      event_collect_timeout: "60s"
+                    codec:
+                      ndjson:
+        EOT
+  max_units                   = 1
+  min_units                   = 1
+  status                      = %[2]q
+}
+`, rName, status)
+}
+
+func testAccPipelineConfig_invalidConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_osis_pipeline" "test" {
+  pipeline_name               = %[1]q
+  pipeline_configuration_body = <<-EOT
+            version: "2"
+            test-pipeline:
+              source:
+                not-a-source:
+                  path: "/test"
+        EOT
+  max_units                   = 1
+  min_units                   = 1
+}
+`, rName)
+}
+
 func testAccPipelineConfig_tags1(rName string, key1, value1 string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
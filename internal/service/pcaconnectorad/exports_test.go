@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+// Exports for use in tests only.
+var (
+	ResourceConnector             = newConnectorResource
+	ResourceDirectoryRegistration = newDirectoryRegistrationResource
+	ResourceServicePrincipalName  = newServicePrincipalNameResource
+
+	FindConnectorByARN                   = findConnectorByARN
+	FindDirectoryRegistrationByARN       = findDirectoryRegistrationByARN
+	FindServicePrincipalNameByTwoPartKey = findServicePrincipalNameByTwoPartKey
+)
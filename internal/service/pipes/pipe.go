@@ -100,7 +100,7 @@ func resourcePipe() *schema.Resource {
 				names.AttrRoleARN: {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidIAMRoleARN,
 				},
 				names.AttrSource: {
 					Type:     schema.TypeString,
@@ -263,6 +263,9 @@ func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		if d.HasChange("enrichment_parameters") {
 			if v, ok := d.GetOk("enrichment_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.EnrichmentParameters = expandPipeEnrichmentParameters(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// Reset state in case it's a deletion.
+				input.EnrichmentParameters = &awstypes.PipeEnrichmentParameters{}
 			}
 		}
 
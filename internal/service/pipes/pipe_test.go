@@ -308,6 +308,14 @@ func TestAccPipesPipe_enrichmentParameters(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.0.http_parameters.0.query_string_parameters.%", acctest.Ct0),
 				),
 			},
+			{
+				Config: testAccPipeConfig_enrichmentParametersRemoved(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipeExists(ctx, resourceName, &pipe),
+					resource.TestCheckResourceAttrPair(resourceName, "enrichment", "aws_cloudwatch_event_api_destination.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "enrichment_parameters.#", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
@@ -391,6 +399,23 @@ func TestAccPipesPipe_update_logConfiguration_cloudwatchLogsLogDestination(t *te
 					resource.TestCheckResourceAttrSet(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.0.log_group_arn"),
 				),
 			},
+			{
+				Config: testAccPipeConfig_logConfiguration_includeExecutionData_cloudwatchLogsLogDestination(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipeExists(ctx, resourceName, &pipe),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.include_execution_data.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_configuration.0.include_execution_data.*", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "log_configuration.0.cloudwatch_logs_log_destination.0.log_group_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -1935,6 +1960,44 @@ resource "aws_pipes_pipe" "test" {
 `, rName))
 }
 
+func testAccPipeConfig_enrichmentParametersRemoved(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipeConfig_base(rName),
+		testAccPipeConfig_baseSQSSource(rName),
+		testAccPipeConfig_baseSQSTarget(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = "testKey"
+      value = "testValue"
+    }
+  }
+}
+
+resource "aws_cloudwatch_event_api_destination" "test" {
+  name                = %[1]q
+  invocation_endpoint = "https://example.com/"
+  http_method         = "POST"
+  connection_arn      = aws_cloudwatch_event_connection.test.arn
+}
+
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+
+  enrichment = aws_cloudwatch_event_api_destination.test.arn
+}
+`, rName))
+}
+
 func testAccPipeConfig_logConfiguration_cloudwatchLogsLogDestination(rName string) string {
 	return acctest.ConfigCompose(
 		testAccPipeConfig_base(rName),
@@ -1989,6 +2052,34 @@ resource "aws_cloudwatch_log_group" "target" {
 `, rName))
 }
 
+func testAccPipeConfig_logConfiguration_includeExecutionData_cloudwatchLogsLogDestination(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipeConfig_base(rName),
+		testAccPipeConfig_baseSQSSource(rName),
+		testAccPipeConfig_baseSQSTarget(rName),
+		fmt.Sprintf(`
+resource "aws_pipes_pipe" "test" {
+  depends_on = [aws_iam_role_policy.source, aws_iam_role_policy.target]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  source   = aws_sqs_queue.source.arn
+  target   = aws_sqs_queue.target.arn
+  log_configuration {
+    include_execution_data = ["ALL"]
+    level                  = "ERROR"
+    cloudwatch_logs_log_destination {
+      log_group_arn = aws_cloudwatch_log_group.target.arn
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "target" {
+  name = "%[1]s-target"
+}
+`, rName))
+}
+
 func testAccPipeConfig_sourceParameters_filterCriteria1(rName, criteria1 string) string {
 	return acctest.ConfigCompose(
 		testAccPipeConfig_base(rName),
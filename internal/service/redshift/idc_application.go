@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="IdC Application")
+func newResourceIdcApplication(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIdcApplication{}, nil
+}
+
+const (
+	ResNameIdcApplication = "IdC Application"
+)
+
+type resourceIdcApplication struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdcApplication) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_redshift_idc_application"
+}
+
+func (r *resourceIdcApplication) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"idc_display_name": schema.StringAttribute{
+				Required: true,
+			},
+			"idc_instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"idc_managed_application_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idc_onboard_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"identity_namespace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redshift_idc_application_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"authorized_token_issuer": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[authorizedTokenIssuerModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authorized_audiences_list": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"trusted_token_issuer_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"service_integration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[serviceIntegrationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"lake_formation": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lakeFormationScopeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"lake_formation_query": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[lakeFormationQueryModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"authorization": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ServiceAuthorization](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIdcApplication) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RedshiftClient(ctx)
+
+	var plan resourceIdcApplicationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &redshift.CreateRedshiftIdcApplicationInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateRedshiftIdcApplication(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Redshift, create.ErrActionCreating, ResNameIdcApplication, plan.RedshiftIdcApplicationName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.RedshiftIdcApplication == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Redshift, create.ErrActionCreating, ResNameIdcApplication, plan.RedshiftIdcApplicationName.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, out.RedshiftIdcApplication)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdcApplication) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RedshiftClient(ctx)
+
+	var state resourceIdcApplicationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findIdcApplicationByARN(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Redshift, create.ErrActionSetting, ResNameIdcApplication, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, out)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdcApplication) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().RedshiftClient(ctx)
+
+	var plan, state resourceIdcApplicationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AuthorizedTokenIssuerList.Equal(state.AuthorizedTokenIssuerList) ||
+		!plan.IAMRoleARN.Equal(state.IAMRoleARN) ||
+		!plan.IdcDisplayName.Equal(state.IdcDisplayName) ||
+		!plan.IdentityNamespace.Equal(state.IdentityNamespace) ||
+		!plan.ServiceIntegrations.Equal(state.ServiceIntegrations) {
+		in := &redshift.ModifyRedshiftIdcApplicationInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.RedshiftIdcApplicationArn = aws.String(plan.ID.ValueString())
+
+		// Send empty lists so that removed configuration is cleared.
+		if in.AuthorizedTokenIssuerList == nil {
+			in.AuthorizedTokenIssuerList = []awstypes.AuthorizedTokenIssuer{}
+		}
+		if in.ServiceIntegrations == nil {
+			in.ServiceIntegrations = []awstypes.ServiceIntegrationsUnion{}
+		}
+
+		out, err := conn.ModifyRedshiftIdcApplication(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Redshift, create.ErrActionUpdating, ResNameIdcApplication, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil || out.RedshiftIdcApplication == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Redshift, create.ErrActionUpdating, ResNameIdcApplication, plan.ID.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.refreshFromOutput(ctx, out.RedshiftIdcApplication)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdcApplication) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RedshiftClient(ctx)
+
+	var state resourceIdcApplicationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteRedshiftIdcApplication(ctx, &redshift.DeleteRedshiftIdcApplicationInput{
+		RedshiftIdcApplicationArn: aws.String(state.ID.ValueString()),
+	})
+	if err != nil {
+		if errs.IsA[*awstypes.RedshiftIdcApplicationNotExistsFault](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Redshift, create.ErrActionDeleting, ResNameIdcApplication, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findIdcApplicationByARN(ctx context.Context, conn *redshift.Client, arn string) (*awstypes.RedshiftIdcApplication, error) {
+	in := &redshift.DescribeRedshiftIdcApplicationsInput{
+		RedshiftIdcApplicationArn: aws.String(arn),
+	}
+
+	out, err := conn.DescribeRedshiftIdcApplications(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.RedshiftIdcApplicationNotExistsFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return tfresource.AssertSingleValueResult(out.RedshiftIdcApplications)
+}
+
+type resourceIdcApplicationData struct {
+	ARN                        types.String                                                `tfsdk:"arn"`
+	AuthorizedTokenIssuerList  fwtypes.ListNestedObjectValueOf[authorizedTokenIssuerModel] `tfsdk:"authorized_token_issuer"`
+	IAMRoleARN                 fwtypes.ARN                                                 `tfsdk:"iam_role_arn"`
+	ID                         types.String                                                `tfsdk:"id"`
+	IdcDisplayName             types.String                                                `tfsdk:"idc_display_name"`
+	IdcInstanceARN             fwtypes.ARN                                                 `tfsdk:"idc_instance_arn"`
+	IdcManagedApplicationARN   types.String                                                `tfsdk:"idc_managed_application_arn"`
+	IdcOnboardStatus           types.String                                                `tfsdk:"idc_onboard_status"`
+	IdentityNamespace          types.String                                                `tfsdk:"identity_namespace"`
+	RedshiftIdcApplicationName types.String                                                `tfsdk:"redshift_idc_application_name"`
+	ServiceIntegrations        fwtypes.ListNestedObjectValueOf[serviceIntegrationModel]    `tfsdk:"service_integration"`
+}
+
+func (m *resourceIdcApplicationData) refreshFromOutput(ctx context.Context, out *awstypes.RedshiftIdcApplication) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flex.Flatten(ctx, out, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ARN = flex.StringToFramework(ctx, out.RedshiftIdcApplicationArn)
+	m.ID = flex.StringToFramework(ctx, out.RedshiftIdcApplicationArn)
+	m.ServiceIntegrations = flattenServiceIntegrations(ctx, out.ServiceIntegrations)
+
+	return diags
+}
+
+type authorizedTokenIssuerModel struct {
+	AuthorizedAudiencesList fwtypes.ListValueOf[types.String] `tfsdk:"authorized_audiences_list"`
+	TrustedTokenIssuerARN   fwtypes.ARN                       `tfsdk:"trusted_token_issuer_arn"`
+}
+
+type serviceIntegrationModel struct {
+	LakeFormation fwtypes.ListNestedObjectValueOf[lakeFormationScopeModel] `tfsdk:"lake_formation"`
+}
+
+var (
+	_ flex.Expander = serviceIntegrationModel{}
+)
+
+func (m serviceIntegrationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.LakeFormation.IsNull():
+		var scopes []awstypes.LakeFormationScopeUnion
+		diags.Append(flex.Expand(ctx, m.LakeFormation, &scopes)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.ServiceIntegrationsUnionMemberLakeFormation{
+			Value: scopes,
+		}, diags
+	}
+
+	return nil, diags
+}
+
+type lakeFormationScopeModel struct {
+	LakeFormationQuery fwtypes.ListNestedObjectValueOf[lakeFormationQueryModel] `tfsdk:"lake_formation_query"`
+}
+
+var (
+	_ flex.Expander = lakeFormationScopeModel{}
+)
+
+func (m lakeFormationScopeModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.LakeFormationQuery.IsNull():
+		lakeFormationQueryData := fwdiag.Must(m.LakeFormationQuery.ToPtr(ctx))
+
+		return &awstypes.LakeFormationScopeUnionMemberLakeFormationQuery{
+			Value: awstypes.LakeFormationQuery{
+				Authorization: lakeFormationQueryData.Authorization.ValueEnum(),
+			},
+		}, diags
+	}
+
+	return nil, diags
+}
+
+type lakeFormationQueryModel struct {
+	Authorization fwtypes.StringEnum[awstypes.ServiceAuthorization] `tfsdk:"authorization"`
+}
+
+func flattenServiceIntegrations(ctx context.Context, apiObjects []awstypes.ServiceIntegrationsUnion) fwtypes.ListNestedObjectValueOf[serviceIntegrationModel] {
+	if len(apiObjects) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[serviceIntegrationModel](ctx)
+	}
+
+	var serviceIntegrationsData []*serviceIntegrationModel
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *awstypes.ServiceIntegrationsUnionMemberLakeFormation:
+			serviceIntegrationsData = append(serviceIntegrationsData, &serviceIntegrationModel{
+				LakeFormation: flattenLakeFormationScopes(ctx, v.Value),
+			})
+		}
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, serviceIntegrationsData)
+}
+
+func flattenLakeFormationScopes(ctx context.Context, apiObjects []awstypes.LakeFormationScopeUnion) fwtypes.ListNestedObjectValueOf[lakeFormationScopeModel] {
+	if len(apiObjects) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[lakeFormationScopeModel](ctx)
+	}
+
+	var lakeFormationScopesData []*lakeFormationScopeModel
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *awstypes.LakeFormationScopeUnionMemberLakeFormationQuery:
+			lakeFormationScopesData = append(lakeFormationScopesData, &lakeFormationScopeModel{
+				LakeFormationQuery: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lakeFormationQueryModel{
+					Authorization: fwtypes.StringEnumValue(v.Value.Authorization),
+				}),
+			})
+		}
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, lakeFormationScopesData)
+}
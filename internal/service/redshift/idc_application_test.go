@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftIdcApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application types.RedshiftIdcApplication
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_idc_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdcApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdcApplicationConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName, &application),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "redshift", regexache.MustCompile(`redshiftidcapplication:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIAMRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "idc_display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "idc_instance_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "idc_managed_application_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_namespace"),
+					resource.TestCheckResourceAttr(resourceName, "redshift_idc_application_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIdcApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application types.RedshiftIdcApplication
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_idc_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdcApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdcApplicationConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName, &application),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceIdcApplication, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIdcApplication_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application types.RedshiftIdcApplication
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	displayNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_idc_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdcApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdcApplicationConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "idc_display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccIdcApplicationConfig_lakeFormation(rName, displayNameUpdated, "Enabled"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "idc_display_name", displayNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.0.lake_formation.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.0.lake_formation.0.lake_formation_query.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.0.lake_formation.0.lake_formation_query.0.authorization", "Enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIdcApplicationConfig_lakeFormation(rName, displayNameUpdated, "Disabled"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdcApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "service_integration.0.lake_formation.0.lake_formation_query.0.authorization", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdcApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_idc_application" {
+				continue
+			}
+
+			_, err := tfredshift.FindIdcApplicationByARN(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return create.Error(names.Redshift, create.ErrActionCheckingDestroyed, tfredshift.ResNameIdcApplication, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.Redshift, create.ErrActionCheckingDestroyed, tfredshift.ResNameIdcApplication, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdcApplicationExists(ctx context.Context, name string, application *types.RedshiftIdcApplication) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Redshift, create.ErrActionCheckingExistence, tfredshift.ResNameIdcApplication, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
+		out, err := tfredshift.FindIdcApplicationByARN(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.Redshift, create.ErrActionCheckingExistence, tfredshift.ResNameIdcApplication, rs.Primary.ID, err)
+		}
+
+		*application = *out
+
+		return nil
+	}
+}
+
+func testAccIdcApplicationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:SetContext"]
+      Effect = "Allow"
+      Principal = {
+        Service = "redshift.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccIdcApplicationConfig_basic(rName, displayName string) string {
+	return acctest.ConfigCompose(testAccIdcApplicationConfigBase(rName), fmt.Sprintf(`
+resource "aws_redshift_idc_application" "test" {
+  iam_role_arn                  = aws_iam_role.test.arn
+  idc_display_name              = %[2]q
+  idc_instance_arn              = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  redshift_idc_application_name = %[1]q
+}
+`, rName, displayName))
+}
+
+func testAccIdcApplicationConfig_lakeFormation(rName, displayName, authorization string) string {
+	return acctest.ConfigCompose(testAccIdcApplicationConfigBase(rName), fmt.Sprintf(`
+resource "aws_redshift_idc_application" "test" {
+  iam_role_arn                  = aws_iam_role.test.arn
+  idc_display_name              = %[2]q
+  idc_instance_arn              = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  redshift_idc_application_name = %[1]q
+
+  service_integration {
+    lake_formation {
+      lake_formation_query {
+        authorization = %[3]q
+      }
+    }
+  }
+}
+`, rName, displayName, authorization))
+}
@@ -41,6 +41,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceDataShareConsumerAssociation,
 			Name:    "Data Share Consumer Association",
 		},
+		{
+			Factory: newResourceIdcApplication,
+			Name:    "IdC Application",
+		},
 		{
 			Factory: newResourceLogging,
 			Name:    "Logging",
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_route53recoverycontrolconfig_cluster")
+func DataSourceCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"cluster_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEndpoint: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	output, err := conn.DescribeClusterWithContext(ctx, &r53rcc.DescribeClusterInput{
+		ClusterArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Cluster (%s): %s", arn, err)
+	}
+
+	if output == nil || output.Cluster == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Cluster (%s): empty response", arn)
+	}
+
+	result := output.Cluster
+	d.SetId(aws.StringValue(result.ClusterArn))
+	d.Set(names.AttrARN, result.ClusterArn)
+	d.Set(names.AttrName, result.Name)
+	d.Set(names.AttrStatus, result.Status)
+
+	if err := d.Set("cluster_endpoints", flattenClusterEndpoints(result.ClusterEndpoints)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cluster_endpoints: %s", err)
+	}
+
+	return diags
+}
@@ -18,6 +18,7 @@ func TestAccRoute53RecoveryControlConfig_serial(t *testing.T) {
 		"Cluster": {
 			acctest.CtBasic:      testAccCluster_basic,
 			acctest.CtDisappears: testAccCluster_disappears,
+			"dataSource":         testAccClusterDataSource_basic,
 		},
 		"ControlPanel": {
 			acctest.CtBasic:      testAccControlPanel_basic,
@@ -28,6 +29,9 @@ func TestAccRoute53RecoveryControlConfig_serial(t *testing.T) {
 			acctest.CtDisappears:    testAccRoutingControl_disappears,
 			"nonDefaultControlPane": testAccRoutingControl_nonDefaultControlPanel,
 		},
+		"RoutingControlState": {
+			acctest.CtBasic: testAccRoutingControlState_basic,
+		},
 		"SafetyRule": {
 			"assertionRule":      testAccSafetyRule_assertionRule,
 			"gatingRule":         testAccSafetyRule_gatingRule,
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rc "github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_route53recoverycontrolconfig_routing_control_state")
+func ResourceRoutingControlState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoutingControlStateUpdate,
+		ReadWithoutTimeout:   resourceRoutingControlStateRead,
+		UpdateWithoutTimeout: resourceRoutingControlStateUpdate,
+		DeleteWithoutTimeout: resourceRoutingControlStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRoutingControlStateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"routing_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"routing_control_state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(r53rc.RoutingControlState_Values(), false),
+			},
+			"safety_rules_to_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceRoutingControlStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	arn := d.Get("routing_control_arn").(string)
+	input := &r53rc.UpdateRoutingControlStateInput{
+		RoutingControlArn:   aws.String(arn),
+		RoutingControlState: aws.String(d.Get("routing_control_state").(string)),
+	}
+
+	if v, ok := d.GetOk("safety_rules_to_override"); ok && v.(*schema.Set).Len() > 0 {
+		input.SafetyRulesToOverride = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	_, err := tryClusterEndpoints(ctx, meta.(*conns.AWSClient), d.Get("cluster_arn").(string), func(conn *r53rc.Route53RecoveryCluster) (interface{}, error) {
+		return conn.UpdateRoutingControlStateWithContext(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Cluster Routing Control (%s) state: %s", arn, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(arn)
+	}
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	outputRaw, err := tryClusterEndpoints(ctx, meta.(*conns.AWSClient), d.Get("cluster_arn").(string), func(conn *r53rc.Route53RecoveryCluster) (interface{}, error) {
+		return conn.GetRoutingControlStateWithContext(ctx, &r53rc.GetRoutingControlStateInput{
+			RoutingControlArn: aws.String(d.Id()),
+		})
+	})
+
+	// The cluster, whose endpoints are needed to read the state, may also have been deleted.
+	if !d.IsNewResource() && (tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, r53rc.ErrCodeResourceNotFoundException)) {
+		log.Printf("[WARN] Route53 Recovery Cluster Routing Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Cluster Routing Control (%s) state: %s", d.Id(), err)
+	}
+
+	output := outputRaw.(*r53rc.GetRoutingControlStateOutput)
+	d.Set("routing_control_arn", output.RoutingControlArn)
+	d.Set("routing_control_state", output.RoutingControlState)
+
+	return diags
+}
+
+func resourceRoutingControlStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Route53 Recovery Cluster Routing Control (%s) state is left unchanged, only removing from Terraform state", d.Id())
+
+	return diags
+}
+
+// resourceRoutingControlStateImport imports by routing control ARN, looking up the cluster via the routing control's control panel.
+func resourceRoutingControlStateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx)
+
+	routingControl, err := conn.DescribeRoutingControlWithContext(ctx, &r53rcc.DescribeRoutingControlInput{
+		RoutingControlArn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route53 Recovery Control Config Routing Control (%s): %w", d.Id(), err)
+	}
+
+	if routingControl == nil || routingControl.RoutingControl == nil {
+		return nil, fmt.Errorf("reading Route53 Recovery Control Config Routing Control (%s): empty result", d.Id())
+	}
+
+	controlPanelARN := aws.StringValue(routingControl.RoutingControl.ControlPanelArn)
+	controlPanel, err := conn.DescribeControlPanelWithContext(ctx, &r53rcc.DescribeControlPanelInput{
+		ControlPanelArn: aws.String(controlPanelARN),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route53 Recovery Control Config Control Panel (%s): %w", controlPanelARN, err)
+	}
+
+	if controlPanel == nil || controlPanel.ControlPanel == nil {
+		return nil, fmt.Errorf("reading Route53 Recovery Control Config Control Panel (%s): empty result", controlPanelARN)
+	}
+
+	d.Set("cluster_arn", controlPanel.ControlPanel.ClusterArn)
+	d.Set("routing_control_arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// tryClusterEndpoints calls f against each of the cluster's regional endpoints in turn until one succeeds.
+// The Route 53 Recovery Cluster data plane is only reachable via cluster endpoints, any of which may be
+// temporarily unavailable, so requests that fail with a retryable error are retried on the next endpoint.
+func tryClusterEndpoints(ctx context.Context, client *conns.AWSClient, clusterARN string, f func(*r53rc.Route53RecoveryCluster) (interface{}, error)) (interface{}, error) {
+	endpoints, err := findClusterEndpointsByARN(ctx, client.Route53RecoveryControlConfigConn(ctx), clusterARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	for _, endpoint := range endpoints {
+		conn := client.Route53RecoveryClusterConnForEndpoint(ctx, aws.StringValue(endpoint.Endpoint), aws.StringValue(endpoint.Region))
+
+		output, err := f(conn)
+
+		if err == nil {
+			return output, nil
+		}
+
+		if !tfawserr.ErrCodeEquals(err, r53rc.ErrCodeEndpointTemporarilyUnavailableException, r53rc.ErrCodeInternalServerException, r53rc.ErrCodeThrottlingException) && !tfawserr.ErrCodeEquals(err, "RequestError") {
+			return nil, err
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", aws.StringValue(endpoint.Endpoint), err))
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func findClusterEndpointsByARN(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) ([]*r53rcc.ClusterEndpoint, error) {
+	input := &r53rcc.DescribeClusterInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil || len(output.Cluster.ClusterEndpoints) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster.ClusterEndpoints, nil
+}
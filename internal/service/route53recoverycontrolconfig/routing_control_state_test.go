@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig_test
+
+import (
+	"fmt"
+	"testing"
+
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRoutingControlState_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53recoverycontrolconfig_routing_control_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, r53rcc.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53RecoveryControlConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, "On"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", "aws_route53recoverycontrolconfig_routing_control.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "routing_control_state", "On"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, "Off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", "aws_route53recoverycontrolconfig_routing_control.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "routing_control_state", "Off"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingControlStateConfig_basic(rName, state string) string {
+	return acctest.ConfigCompose(
+		testAccRoutingControlConfig_inDefaultPanel(rName), fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_routing_control_state" "test" {
+  cluster_arn           = aws_route53recoverycontrolconfig_cluster.test.arn
+  routing_control_arn   = aws_route53recoverycontrolconfig_routing_control.test.arn
+  routing_control_state = %[1]q
+}
+`, state))
+}
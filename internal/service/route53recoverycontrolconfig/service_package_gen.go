@@ -21,7 +21,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceCluster,
+			TypeName: "aws_route53recoverycontrolconfig_cluster",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -38,6 +43,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRoutingControl,
 			TypeName: "aws_route53recoverycontrolconfig_routing_control",
 		},
+		{
+			Factory:  ResourceRoutingControlState,
+			TypeName: "aws_route53recoverycontrolconfig_routing_control_state",
+		},
 		{
 			Factory:  ResourceSafetyRule,
 			TypeName: "aws_route53recoverycontrolconfig_safety_rule",
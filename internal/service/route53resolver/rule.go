@@ -27,6 +27,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// ruleTargetIPsMax is the maximum number of target IP addresses per rule.
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-entities-resolver.
+const ruleTargetIPsMax = 6
+
 // @SDKResource("aws_route53_resolver_rule", name="Rule")
 // @Tags(identifierAttribute="arn")
 func ResourceRule() *schema.Resource {
@@ -192,6 +196,33 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
 
+	// When target IPs are both added and removed, first forward to the union of the old and new
+	// targets so that resolution isn't interrupted while the rule switches over.
+	// The step is skipped if the union would exceed the per-rule target IP limit.
+	if d.HasChange("target_ip") && !d.HasChange("resolver_endpoint_id") {
+		o, n := d.GetChange("target_ip")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if targetIPs := ruleTargetIPsTransition(os, ns); len(targetIPs) > ns.Len() && len(targetIPs) <= ruleTargetIPsMax && ns.Difference(os).Len() > 0 {
+			input := &route53resolver.UpdateResolverRuleInput{
+				Config: &route53resolver.ResolverRuleConfig{
+					TargetIps: targetIPs,
+				},
+				ResolverRuleId: aws.String(d.Id()),
+			}
+
+			_, err := conn.UpdateResolverRuleWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Route53 Resolver Rule (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitRuleUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Rule (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChanges(names.AttrName, "resolver_endpoint_id", "target_ip") {
 		input := &route53resolver.UpdateResolverRuleInput{
 			Config:         &route53resolver.ResolverRuleConfig{},
@@ -389,6 +420,28 @@ func expandRuleTargetIPs(vTargetIps *schema.Set) []*route53resolver.TargetAddres
 	return targetAddresses
 }
 
+// ruleTargetIPsTransition returns the new target IPs plus any old target IPs whose address is not also a new target.
+// Each IP address appears at most once, as a rule can't forward to the same address on different ports or protocols at once.
+func ruleTargetIPsTransition(os, ns *schema.Set) []*route53resolver.TargetAddress {
+	targetAddresses := expandRuleTargetIPs(ns)
+	ips := make(map[string]struct{})
+
+	for _, v := range targetAddresses {
+		ips[aws.StringValue(v.Ip)] = struct{}{}
+	}
+
+	for _, v := range expandRuleTargetIPs(os) {
+		if _, ok := ips[aws.StringValue(v.Ip)]; ok {
+			continue
+		}
+
+		ips[aws.StringValue(v.Ip)] = struct{}{}
+		targetAddresses = append(targetAddresses, v)
+	}
+
+	return targetAddresses
+}
+
 func flattenRuleTargetIPs(targetAddresses []*route53resolver.TargetAddress) []interface{} {
 	if targetAddresses == nil {
 		return []interface{}{}
@@ -6,6 +6,7 @@ package route53resolver_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -305,6 +306,60 @@ func TestAccRoute53ResolverRule_forward(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverRule_forwardTargetIPReplace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule1, rule2, rule3 route53resolver.ResolverRule
+	resourceName := "aws_route53_resolver_rule.test"
+	domainName := acctest.RandomDomainName()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_forwardTargetIPs(rName, domainName, "192.0.2.1:53", "192.0.2.2:53"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", acctest.Ct2),
+				),
+			},
+			{
+				// Same IP address with a different port, plus a new address.
+				Config: testAccRuleConfig_forwardTargetIPs(rName, domainName, "192.0.2.1:54", "192.0.2.3:53"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule2),
+					testAccCheckRulesSame(&rule2, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ip":           "192.0.2.1",
+						names.AttrPort: "54",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ip":           "192.0.2.3",
+						names.AttrPort: "53",
+					}),
+				),
+			},
+			{
+				// The union of old and new targets exceeds the per-rule limit.
+				Config: testAccRuleConfig_forwardTargetIPs(rName, domainName, "192.0.2.11:53", "192.0.2.12:53", "192.0.2.13:53", "192.0.2.14:53", "192.0.2.15:53"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule3),
+					testAccCheckRulesSame(&rule3, &rule2),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ip":           "192.0.2.15",
+						names.AttrPort: "53",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverRule_forwardMultiProtocol(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rule route53resolver.ResolverRule
@@ -595,6 +650,28 @@ resource "aws_route53_resolver_rule" "test" {
 `, rName, domainName))
 }
 
+// testAccRuleConfig_forwardTargetIPs configures one target IP for each "ip:port" argument.
+func testAccRuleConfig_forwardTargetIPs(rName, domainName string, targetIPs ...string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_resolverEndpointBase(rName), fmt.Sprintf(`
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = %[2]q
+  rule_type   = "FORWARD"
+  name        = %[1]q
+
+  resolver_endpoint_id = aws_route53_resolver_endpoint.test[0].id
+
+  dynamic "target_ip" {
+    for_each = [%[3]s]
+
+    content {
+      ip   = split(":", target_ip.value)[0]
+      port = split(":", target_ip.value)[1]
+    }
+  }
+}
+`, rName, domainName, `"`+strings.Join(targetIPs, `", "`)+`"`))
+}
+
 func testAccRuleConfig_forwardEndpointChanged(rName, domainName string) string {
 	return acctest.ConfigCompose(testAccRuleConfig_resolverEndpointBase(rName), fmt.Sprintf(`
 resource "aws_route53_resolver_rule" "test" {
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	bucketABACTagPolicyStatementID = "TagBasedAccess"
+)
+
+// @SDKResource("aws_s3_bucket_abac_tag_policy", name="Bucket ABAC Tag Policy")
+func resourceBucketABACTagPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketABACTagPolicyPut,
+		ReadWithoutTimeout:   resourceBucketABACTagPolicyRead,
+		UpdateWithoutTimeout: resourceBucketABACTagPolicyPut,
+		DeleteWithoutTimeout: resourceBucketABACTagPolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrActions: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3:[0-9A-Za-z*]+$`), "must be an S3 action, e.g. s3:GetObject"),
+				},
+			},
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrPolicy: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"tag_keys": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+
+		CustomizeDiff: resourceBucketABACTagPolicyCustomizeDiff,
+	}
+}
+
+func resourceBucketABACTagPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	policy, err := renderBucketABACTagPolicy(meta.(*conns.AWSClient).Partition, bucket, d.Get("principals").(*schema.Set), d.Get(names.AttrActions).(*schema.Set), d.Get("tag_keys").(*schema.Set))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketPolicy(ctx, input)
+	}, errCodeMalformedPolicy, errCodeNoSuchBucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting S3 Bucket (%s) ABAC Tag Policy: %s", bucket, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(bucket)
+
+		_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+			return findBucketPolicy(ctx, conn, d.Id())
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket ABAC Tag Policy (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBucketABACTagPolicyRead(ctx, d, meta)...)
+}
+
+func resourceBucketABACTagPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	policy, err := findBucketPolicy(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket ABAC Tag Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket ABAC Tag Policy (%s): %s", d.Id(), err)
+	}
+
+	policy, err = verify.PolicyToSet(d.Get(names.AttrPolicy).(string), policy)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrBucket, d.Id())
+	d.Set(names.AttrPolicy, policy)
+
+	return diags
+}
+
+func resourceBucketABACTagPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	log.Printf("[DEBUG] Deleting S3 Bucket ABAC Tag Policy: %s", d.Id())
+	_, err := conn.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket ABAC Tag Policy (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketPolicy(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket ABAC Tag Policy (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceBucketABACTagPolicyCustomizeDiff renders the bucket policy at plan time.
+// A change is only planned if the rendered policy isn't equivalent to the policy currently attached to the bucket.
+func resourceBucketABACTagPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{names.AttrActions, names.AttrBucket, "principals", "tag_keys"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed(names.AttrPolicy)
+		}
+	}
+
+	policy, err := renderBucketABACTagPolicy(meta.(*conns.AWSClient).Partition, d.Get(names.AttrBucket).(string), d.Get("principals").(*schema.Set), d.Get(names.AttrActions).(*schema.Set), d.Get("tag_keys").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	if old := d.Get(names.AttrPolicy).(string); old != "" && verify.PolicyStringsEquivalent(old, policy) {
+		return nil
+	}
+
+	return d.SetNew(names.AttrPolicy, policy)
+}
+
+// renderBucketABACTagPolicy renders a bucket policy that allows the principals to perform the actions on objects
+// whose tags match the principal's tags with the same keys.
+func renderBucketABACTagPolicy(partition, bucket string, principals, actions, tagKeys *schema.Set) (string, error) {
+	principalARNs := flex.ExpandStringValueSet(principals)
+	slices.Sort(principalARNs)
+	actionNames := flex.ExpandStringValueSet(actions)
+	slices.Sort(actionNames)
+	keys := flex.ExpandStringValueSet(tagKeys)
+	slices.Sort(keys)
+
+	var conditions tfiam.IAMPolicyStatementConditionSet
+	for _, key := range keys {
+		conditions = append(conditions, tfiam.IAMPolicyStatementCondition{
+			Test:     "StringEquals",
+			Variable: "s3:ExistingObjectTag/" + key,
+			Values:   fmt.Sprintf("${aws:PrincipalTag/%s}", key),
+		})
+	}
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Sid:     bucketABACTagPolicyStatementID,
+				Effect:  "Allow",
+				Actions: actionNames,
+				Resources: arn.ARN{
+					Partition: partition,
+					Service:   "s3",
+					Resource:  bucket + "/*",
+				}.String(),
+				Principals: tfiam.IAMPolicyStatementPrincipalSet{
+					{
+						Type:        "AWS",
+						Identifiers: principalARNs,
+					},
+				},
+				Conditions: conditions,
+			},
+		},
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
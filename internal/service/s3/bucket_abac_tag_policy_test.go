@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketABACTagPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_abac_tag_policy.test"
+
+	expectedPolicyTemplate1 := `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "TagBasedAccess",
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:%[2]s:iam::%[1]s:root"},
+    "Action": "s3:GetObject",
+    "Resource": "arn:%[2]s:s3:::%[3]s/*",
+    "Condition": {
+      "StringEquals": {
+        "s3:ExistingObjectTag/project": "${aws:PrincipalTag/project}"
+      }
+    }
+  }]
+}`
+
+	expectedPolicyTemplate2 := `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "TagBasedAccess",
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:%[2]s:iam::%[1]s:root"},
+    "Action": ["s3:GetObject", "s3:PutObject"],
+    "Resource": "arn:%[2]s:s3:::%[3]s/*",
+    "Condition": {
+      "StringEquals": {
+        "s3:ExistingObjectTag/project": "${aws:PrincipalTag/project}",
+        "s3:ExistingObjectTag/team": "${aws:PrincipalTag/team}"
+      }
+    }
+  }]
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketABACTagPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketABACTagPolicyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketHasPolicy(ctx, "aws_s3_bucket.test", expectedPolicyTemplate1, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tag_keys.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				Config: testAccBucketABACTagPolicyConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketHasPolicy(ctx, "aws_s3_bucket.test", expectedPolicyTemplate2, rName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tag_keys.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketABACTagPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_abac_tag_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketABACTagPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketABACTagPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketABACTagPolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketABACTagPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketABACTagPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_abac_tag_policy" {
+				continue
+			}
+
+			_, err := tfs3.FindBucketPolicy(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket ABAC Tag Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketABACTagPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindBucketPolicy(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccBucketABACTagPolicyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_abac_tag_policy" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  actions    = ["s3:GetObject"]
+  principals = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+  tag_keys   = ["project"]
+}
+`, rName)
+}
+
+func testAccBucketABACTagPolicyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_abac_tag_policy" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  actions    = ["s3:GetObject", "s3:PutObject"]
+  principals = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+  tag_keys   = ["project", "team"]
+}
+`, rName)
+}
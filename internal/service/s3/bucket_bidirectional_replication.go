@@ -0,0 +1,525 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	bucketBidirectionalReplicationResourceIDPartCount = 2
+	// The ID of the replication rule managed on each bucket.
+	bucketBidirectionalReplicationRuleID = "terraform-bidirectional-replication"
+)
+
+// @SDKResource("aws_s3_bucket_bidirectional_replication", name="Bucket Bidirectional Replication")
+func resourceBucketBidirectionalReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketBidirectionalReplicationCreate,
+		ReadWithoutTimeout:   resourceBucketBidirectionalReplicationRead,
+		UpdateWithoutTimeout: resourceBucketBidirectionalReplicationUpdate,
+		DeleteWithoutTimeout: resourceBucketBidirectionalReplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delete_marker_replication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"first_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"first_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"metrics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrPrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"replica_modifications": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"replication_time": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"second_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"second_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStorageClass: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StorageClass](),
+			},
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Get("first_bucket").(string) == d.Get("second_bucket").(string) {
+				return fmt.Errorf("first_bucket and second_bucket must be different")
+			}
+
+			// S3 Replication Time Control requires replication metrics.
+			if d.Get("replication_time").(bool) && !d.Get("metrics").(bool) {
+				return fmt.Errorf("metrics must be enabled when replication_time is enabled")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceBucketBidirectionalReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	firstBucket, secondBucket := d.Get("first_bucket").(string), d.Get("second_bucket").(string)
+	id, err := flex.FlattenResourceId([]string{firstBucket, secondBucket}, bucketBidirectionalReplicationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := checkBucketsInProviderRegion(ctx, meta.(*conns.AWSClient), firstBucket, secondBucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Bidirectional Replication (%s): %s", id, err)
+	}
+
+	if err := checkBucketVersioningEnabled(ctx, conn, firstBucket, secondBucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Bidirectional Replication (%s): %s", id, err)
+	}
+
+	if err := putBucketBidirectionalReplication(ctx, conn, d, meta.(*conns.AWSClient).Partition, firstBucket, d.Get("first_role").(string), secondBucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Bidirectional Replication (%s): %s", id, err)
+	}
+
+	if err := putBucketBidirectionalReplication(ctx, conn, d, meta.(*conns.AWSClient).Partition, secondBucket, d.Get("second_role").(string), firstBucket); err != nil {
+		// Don't leave one-way replication behind.
+		if err := deleteBucketBidirectionalReplicationRule(ctx, conn, firstBucket); err != nil {
+			log.Printf("[WARN] Deleting S3 Bucket (%s) Replication Configuration: %s", firstBucket, err)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Bidirectional Replication (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	for _, bucket := range []string{firstBucket, secondBucket} {
+		_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+			return findBucketBidirectionalReplicationRule(ctx, conn, bucket)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Bidirectional Replication (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBucketBidirectionalReplicationRead(ctx, d, meta)...)
+}
+
+func resourceBucketBidirectionalReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), bucketBidirectionalReplicationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	firstBucket, secondBucket := parts[0], parts[1]
+	firstConfig, err := findBucketBidirectionalReplicationRule(ctx, conn, firstBucket)
+
+	var secondConfig *types.ReplicationConfiguration
+	if err == nil {
+		secondConfig, err = findBucketBidirectionalReplicationRule(ctx, conn, secondBucket)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Bidirectional Replication (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Bidirectional Replication (%s): %s", d.Id(), err)
+	}
+
+	// Both directions are configured with the same settings.
+	// Where the two rules differ, report the value that differs from the prior state so that drift on either bucket is detected.
+	first, second := flattenBucketBidirectionalReplicationRule(firstConfig.Rules[0]), flattenBucketBidirectionalReplicationRule(secondConfig.Rules[0])
+
+	d.Set("delete_marker_replication", bucketBidirectionalReplicationDriftedValue(d, "delete_marker_replication", first.deleteMarkerReplication, second.deleteMarkerReplication))
+	d.Set("first_bucket", firstBucket)
+	d.Set("first_role", firstConfig.Role)
+	d.Set("metrics", bucketBidirectionalReplicationDriftedValue(d, "metrics", first.metrics, second.metrics))
+	d.Set(names.AttrPrefix, bucketBidirectionalReplicationDriftedValue(d, names.AttrPrefix, first.prefix, second.prefix))
+	d.Set("replica_modifications", bucketBidirectionalReplicationDriftedValue(d, "replica_modifications", first.replicaModifications, second.replicaModifications))
+	d.Set("replication_time", bucketBidirectionalReplicationDriftedValue(d, "replication_time", first.replicationTime, second.replicationTime))
+	d.Set("second_bucket", secondBucket)
+	d.Set("second_role", secondConfig.Role)
+	d.Set(names.AttrStorageClass, bucketBidirectionalReplicationDriftedValue(d, names.AttrStorageClass, first.storageClass, second.storageClass))
+
+	return diags
+}
+
+func resourceBucketBidirectionalReplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	firstBucket, secondBucket := d.Get("first_bucket").(string), d.Get("second_bucket").(string)
+
+	if err := checkBucketsInProviderRegion(ctx, meta.(*conns.AWSClient), firstBucket, secondBucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Bidirectional Replication (%s): %s", d.Id(), err)
+	}
+
+	if err := checkBucketVersioningEnabled(ctx, conn, firstBucket, secondBucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Bidirectional Replication (%s): %s", d.Id(), err)
+	}
+
+	if err := putBucketBidirectionalReplication(ctx, conn, d, meta.(*conns.AWSClient).Partition, firstBucket, d.Get("first_role").(string), secondBucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Bidirectional Replication (%s): %s", d.Id(), err)
+	}
+
+	if err := putBucketBidirectionalReplication(ctx, conn, d, meta.(*conns.AWSClient).Partition, secondBucket, d.Get("second_role").(string), firstBucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Bidirectional Replication (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketBidirectionalReplicationRead(ctx, d, meta)...)
+}
+
+func resourceBucketBidirectionalReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), bucketBidirectionalReplicationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	for _, bucket := range parts {
+		log.Printf("[DEBUG] Deleting S3 Bucket (%s) Bidirectional Replication rule", bucket)
+		if err := deleteBucketBidirectionalReplicationRule(ctx, conn, bucket); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Bidirectional Replication (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// checkBucketsInProviderRegion returns an error if any of the specified buckets is not in the provider's Region.
+// All requests are made with the provider's S3 client, which cannot manage buckets in other Regions.
+func checkBucketsInProviderRegion(ctx context.Context, awsClient *conns.AWSClient, buckets ...string) error {
+	for _, bucket := range buckets {
+		region, err := findBucketRegion(ctx, awsClient, bucket)
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Bucket (%s) Region: %w", bucket, err)
+		}
+
+		if region != awsClient.Region {
+			return fmt.Errorf("S3 Bucket (%s) is in Region (%s); both buckets must be in the provider Region (%s)", bucket, region, awsClient.Region)
+		}
+	}
+
+	return nil
+}
+
+func checkBucketVersioningEnabled(ctx context.Context, conn *s3.Client, buckets ...string) error {
+	for _, bucket := range buckets {
+		output, err := findBucketVersioning(ctx, conn, bucket, "")
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Bucket (%s) Versioning: %w", bucket, err)
+		}
+
+		if output.Status != types.BucketVersioningStatusEnabled {
+			return fmt.Errorf("versioning must be enabled on S3 Bucket (%s)", bucket)
+		}
+	}
+
+	return nil
+}
+
+func putBucketBidirectionalReplication(ctx context.Context, conn *s3.Client, d *schema.ResourceData, partition, bucket, role, destinationBucket string) error {
+	rule := types.ReplicationRule{
+		DeleteMarkerReplication: &types.DeleteMarkerReplication{
+			Status: types.DeleteMarkerReplicationStatusDisabled,
+		},
+		Destination: &types.Destination{
+			Bucket: aws.String(arn.ARN{
+				Partition: partition,
+				Service:   "s3",
+				Resource:  destinationBucket,
+			}.String()),
+		},
+		Filter: &types.ReplicationRuleFilterMemberPrefix{
+			Value: d.Get(names.AttrPrefix).(string),
+		},
+		ID:       aws.String(bucketBidirectionalReplicationRuleID),
+		Priority: aws.Int32(0),
+		Status:   types.ReplicationRuleStatusEnabled,
+	}
+
+	if d.Get("delete_marker_replication").(bool) {
+		rule.DeleteMarkerReplication.Status = types.DeleteMarkerReplicationStatusEnabled
+	}
+
+	if d.Get("metrics").(bool) {
+		rule.Destination.Metrics = &types.Metrics{
+			EventThreshold: &types.ReplicationTimeValue{
+				Minutes: aws.Int32(15),
+			},
+			Status: types.MetricsStatusEnabled,
+		}
+	}
+
+	if d.Get("replica_modifications").(bool) {
+		rule.SourceSelectionCriteria = &types.SourceSelectionCriteria{
+			ReplicaModifications: &types.ReplicaModifications{
+				Status: types.ReplicaModificationsStatusEnabled,
+			},
+		}
+	}
+
+	if d.Get("replication_time").(bool) {
+		rule.Destination.ReplicationTime = &types.ReplicationTime{
+			Status: types.ReplicationTimeStatusEnabled,
+			Time: &types.ReplicationTimeValue{
+				Minutes: aws.Int32(15),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk(names.AttrStorageClass); ok {
+		rule.Destination.StorageClass = types.StorageClass(v.(string))
+	}
+
+	// Preserve any replication rules that are managed elsewhere.
+	// A bucket's replication configuration has a single IAM role, which applies to all of its rules.
+	// Refuse to merge rather than change the role used by rules this resource doesn't manage.
+	otherRole, rules, err := findBucketOtherReplicationRules(ctx, conn, bucket)
+
+	if err != nil {
+		return fmt.Errorf("reading S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	if len(rules) > 0 && otherRole != role {
+		return fmt.Errorf("S3 Bucket (%s) has other replication rules that use IAM role (%s); the role must be %s", bucket, otherRole, role)
+	}
+
+	// Rule priorities must be unique within a bucket's replication configuration.
+	for _, v := range rules {
+		if p := aws.ToInt32(v.Priority); p >= aws.ToInt32(rule.Priority) {
+			rule.Priority = aws.Int32(p + 1)
+		}
+	}
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &types.ReplicationConfiguration{
+			Role:  aws.String(role),
+			Rules: append(rules, rule),
+		},
+	}
+
+	_, err = tfresource.RetryWhen(ctx, bucketPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.PutBucketReplication(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) || tfawserr.ErrMessageContains(err, errCodeInvalidRequest, "Versioning must be 'Enabled' on the bucket") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("putting S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	return nil
+}
+
+// deleteBucketBidirectionalReplicationRule removes the managed replication rule from the specified bucket.
+// The bucket's replication configuration is deleted only if no other rules remain.
+func deleteBucketBidirectionalReplicationRule(ctx context.Context, conn *s3.Client, bucket string) error {
+	output, err := findReplicationConfiguration(ctx, conn, bucket)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	rules := tfslices.Filter(output.Rules, func(v types.ReplicationRule) bool {
+		return aws.ToString(v.ID) != bucketBidirectionalReplicationRuleID
+	})
+
+	if len(rules) == len(output.Rules) {
+		return nil
+	}
+
+	if len(rules) == 0 {
+		_, err = conn.DeleteBucketReplication(ctx, &s3.DeleteBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		})
+	} else {
+		_, err = conn.PutBucketReplication(ctx, &s3.PutBucketReplicationInput{
+			Bucket: aws.String(bucket),
+			ReplicationConfiguration: &types.ReplicationConfiguration{
+				Role:  output.Role,
+				Rules: rules,
+			},
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting S3 Bucket (%s) Replication Configuration rule (%s): %w", bucket, bucketBidirectionalReplicationRuleID, err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketBidirectionalReplicationRule(ctx, conn, bucket)
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for S3 Bucket (%s) Replication Configuration rule (%s) delete: %w", bucket, bucketBidirectionalReplicationRuleID, err)
+	}
+
+	return nil
+}
+
+// findBucketOtherReplicationRules returns the specified bucket's replication role and rules other than the managed rule.
+func findBucketOtherReplicationRules(ctx context.Context, conn *s3.Client, bucket string) (string, []types.ReplicationRule, error) {
+	output, err := findReplicationConfiguration(ctx, conn, bucket)
+
+	if tfresource.NotFound(err) {
+		return "", nil, nil
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return aws.ToString(output.Role), tfslices.Filter(output.Rules, func(v types.ReplicationRule) bool {
+		return aws.ToString(v.ID) != bucketBidirectionalReplicationRuleID
+	}), nil
+}
+
+func findBucketBidirectionalReplicationRule(ctx context.Context, conn *s3.Client, bucket string) (*types.ReplicationConfiguration, error) {
+	output, err := findReplicationConfiguration(ctx, conn, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.Rules {
+		if aws.ToString(v.ID) == bucketBidirectionalReplicationRuleID {
+			return &types.ReplicationConfiguration{
+				Role:  output.Role,
+				Rules: []types.ReplicationRule{v},
+			}, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		Message: fmt.Sprintf("S3 Bucket (%s) replication rule (%s) not found", bucket, bucketBidirectionalReplicationRuleID),
+	}
+}
+
+type bucketBidirectionalReplicationRuleSettings struct {
+	deleteMarkerReplication bool
+	metrics                 bool
+	prefix                  string
+	replicaModifications    bool
+	replicationTime         bool
+	storageClass            string
+}
+
+func flattenBucketBidirectionalReplicationRule(rule types.ReplicationRule) bucketBidirectionalReplicationRuleSettings {
+	settings := bucketBidirectionalReplicationRuleSettings{
+		deleteMarkerReplication: rule.DeleteMarkerReplication != nil && rule.DeleteMarkerReplication.Status == types.DeleteMarkerReplicationStatusEnabled,
+	}
+
+	if v, ok := rule.Filter.(*types.ReplicationRuleFilterMemberPrefix); ok {
+		settings.prefix = v.Value
+	}
+
+	if v := rule.SourceSelectionCriteria; v != nil && v.ReplicaModifications != nil {
+		settings.replicaModifications = v.ReplicaModifications.Status == types.ReplicaModificationsStatusEnabled
+	}
+
+	if v := rule.Destination; v != nil {
+		settings.metrics = v.Metrics != nil && v.Metrics.Status == types.MetricsStatusEnabled
+		settings.replicationTime = v.ReplicationTime != nil && v.ReplicationTime.Status == types.ReplicationTimeStatusEnabled
+		settings.storageClass = string(v.StorageClass)
+	}
+
+	return settings
+}
+
+// bucketBidirectionalReplicationDriftedValue returns the value of an argument that is applied to both buckets.
+// If the buckets' values differ, the one that differs from the prior state is returned.
+func bucketBidirectionalReplicationDriftedValue[T comparable](d *schema.ResourceData, key string, first, second T) T {
+	if first == second {
+		return first
+	}
+
+	if v, ok := d.Get(key).(T); ok && v == first {
+		return second
+	}
+
+	return first
+}
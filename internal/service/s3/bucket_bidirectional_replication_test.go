@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketBidirectionalReplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_bidirectional_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_marker_replication", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "first_bucket", "aws_s3_bucket.first", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "first_role", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "metrics", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPrefix, ""),
+					resource.TestCheckResourceAttr(resourceName, "replica_modifications", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "replication_time", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "second_bucket", "aws_s3_bucket.second", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "second_role", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketBidirectionalReplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_bidirectional_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketBidirectionalReplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketBidirectionalReplication_replicationTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_bidirectional_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_replicationTime(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_marker_replication", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "metrics", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPrefix, "data/"),
+					resource.TestCheckResourceAttr(resourceName, "replication_time", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_replicationTime(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metrics", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "replication_time", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketBidirectionalReplication_replicationTimeWithoutMetrics(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketBidirectionalReplicationConfig_replicationTimeWithoutMetrics(rName),
+				ExpectError: regexache.MustCompile(`metrics must be enabled when replication_time is enabled`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketBidirectionalReplication_versioningNotEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketBidirectionalReplicationConfig_versioningNotEnabled(rName),
+				ExpectError: regexache.MustCompile(`versioning must be enabled on S3 Bucket`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketBidirectionalReplication_otherRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_bidirectional_replication.test"
+	otherRuleID := "other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					testAccCheckBucketBidirectionalReplicationAddOtherRule(ctx, "aws_s3_bucket.first", otherRuleID),
+				),
+			},
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_replicationTime(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					testAccCheckBucketBidirectionalReplicationOtherRuleExists(ctx, "aws_s3_bucket.first", otherRuleID),
+					resource.TestCheckResourceAttr(resourceName, "replication_time", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_versioned(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationOtherRuleExists(ctx, "aws_s3_bucket.first", otherRuleID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketBidirectionalReplication_otherRulesRoleMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_bidirectional_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_firstRole(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					testAccCheckBucketBidirectionalReplicationAddOtherRule(ctx, "aws_s3_bucket.first", "other"),
+				),
+			},
+			{
+				Config:      testAccBucketBidirectionalReplicationConfig_firstRole(rName, "test2"),
+				ExpectError: regexache.MustCompile(`has other replication rules that use IAM role`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketBidirectionalReplication_secondBucketDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_bidirectional_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketBidirectionalReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					testAccCheckBucketBidirectionalReplicationDisableReplicaModifications(ctx, "aws_s3_bucket.second"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketBidirectionalReplicationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketBidirectionalReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replica_modifications", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketBidirectionalReplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_bidirectional_replication" {
+				continue
+			}
+
+			for _, bucket := range []string{rs.Primary.Attributes["first_bucket"], rs.Primary.Attributes["second_bucket"]} {
+				_, err := tfs3.FindBucketBidirectionalReplicationRule(ctx, conn, bucket)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Bucket Bidirectional Replication %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketBidirectionalReplicationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, bucket := range []string{rs.Primary.Attributes["first_bucket"], rs.Primary.Attributes["second_bucket"]} {
+			if _, err := tfs3.FindBucketBidirectionalReplicationRule(ctx, conn, bucket); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckBucketBidirectionalReplicationAddOtherRule adds a replication rule that is not managed by the resource to the specified bucket.
+func testAccCheckBucketBidirectionalReplicationAddOtherRule(ctx context.Context, n, ruleID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+
+		output, err := tfs3.FindReplicationConfiguration(ctx, conn, bucket)
+
+		if err != nil {
+			return err
+		}
+
+		rule := types.ReplicationRule{
+			DeleteMarkerReplication: &types.DeleteMarkerReplication{
+				Status: types.DeleteMarkerReplicationStatusDisabled,
+			},
+			Destination: &types.Destination{
+				Bucket: output.Rules[0].Destination.Bucket,
+			},
+			Filter: &types.ReplicationRuleFilterMemberPrefix{
+				Value: "other/",
+			},
+			ID:       aws.String(ruleID),
+			Priority: aws.Int32(10),
+			Status:   types.ReplicationRuleStatusEnabled,
+		}
+
+		_, err = conn.PutBucketReplication(ctx, &s3.PutBucketReplicationInput{
+			Bucket: aws.String(bucket),
+			ReplicationConfiguration: &types.ReplicationConfiguration{
+				Role:  output.Role,
+				Rules: append(output.Rules, rule),
+			},
+		})
+
+		return err
+	}
+}
+
+// testAccCheckBucketBidirectionalReplicationDisableReplicaModifications disables replica modification sync in the managed replication rule of the specified bucket.
+func testAccCheckBucketBidirectionalReplicationDisableReplicaModifications(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+
+		output, err := tfs3.FindReplicationConfiguration(ctx, conn, bucket)
+
+		if err != nil {
+			return err
+		}
+
+		for i, v := range output.Rules {
+			if v.SourceSelectionCriteria != nil && v.SourceSelectionCriteria.ReplicaModifications != nil {
+				output.Rules[i].SourceSelectionCriteria.ReplicaModifications.Status = types.ReplicaModificationsStatusDisabled
+			}
+		}
+
+		_, err = conn.PutBucketReplication(ctx, &s3.PutBucketReplicationInput{
+			Bucket:                   aws.String(bucket),
+			ReplicationConfiguration: output,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckBucketBidirectionalReplicationOtherRuleExists(ctx context.Context, n, ruleID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+
+		output, err := tfs3.FindReplicationConfiguration(ctx, conn, bucket)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Rules {
+			if aws.ToString(v.ID) == ruleID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("S3 Bucket (%s) replication rule (%s) not found", bucket, ruleID)
+	}
+}
+
+func testAccBucketBidirectionalReplicationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "s3.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "first" {
+  bucket = "%[1]s-first"
+}
+
+resource "aws_s3_bucket" "second" {
+  bucket = "%[1]s-second"
+}
+`, rName)
+}
+
+func testAccBucketBidirectionalReplicationConfig_versioned(rName string) string {
+	return acctest.ConfigCompose(testAccBucketBidirectionalReplicationConfig_base(rName), `
+resource "aws_s3_bucket_versioning" "first" {
+  bucket = aws_s3_bucket.first.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_versioning" "second" {
+  bucket = aws_s3_bucket.second.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+`)
+}
+
+func testAccBucketBidirectionalReplicationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketBidirectionalReplicationConfig_versioned(rName), `
+resource "aws_s3_bucket_bidirectional_replication" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.first,
+    aws_s3_bucket_versioning.second,
+  ]
+
+  first_bucket  = aws_s3_bucket.first.bucket
+  first_role    = aws_iam_role.test.arn
+  second_bucket = aws_s3_bucket.second.bucket
+  second_role   = aws_iam_role.test.arn
+}
+`)
+}
+
+func testAccBucketBidirectionalReplicationConfig_replicationTime(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccBucketBidirectionalReplicationConfig_versioned(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_bidirectional_replication" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.first,
+    aws_s3_bucket_versioning.second,
+  ]
+
+  first_bucket  = aws_s3_bucket.first.bucket
+  first_role    = aws_iam_role.test.arn
+  second_bucket = aws_s3_bucket.second.bucket
+  second_role   = aws_iam_role.test.arn
+
+  delete_marker_replication = true
+  metrics                   = %[1]t
+  prefix                    = "data/"
+  replication_time          = %[1]t
+}
+`, enabled))
+}
+
+func testAccBucketBidirectionalReplicationConfig_replicationTimeWithoutMetrics(rName string) string {
+	return acctest.ConfigCompose(testAccBucketBidirectionalReplicationConfig_versioned(rName), `
+resource "aws_s3_bucket_bidirectional_replication" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.first,
+    aws_s3_bucket_versioning.second,
+  ]
+
+  first_bucket  = aws_s3_bucket.first.bucket
+  first_role    = aws_iam_role.test.arn
+  second_bucket = aws_s3_bucket.second.bucket
+  second_role   = aws_iam_role.test.arn
+
+  replication_time = true
+}
+`)
+}
+
+func testAccBucketBidirectionalReplicationConfig_versioningNotEnabled(rName string) string {
+	return acctest.ConfigCompose(testAccBucketBidirectionalReplicationConfig_base(rName), `
+resource "aws_s3_bucket_bidirectional_replication" "test" {
+  first_bucket  = aws_s3_bucket.first.bucket
+  first_role    = aws_iam_role.test.arn
+  second_bucket = aws_s3_bucket.second.bucket
+  second_role   = aws_iam_role.test.arn
+}
+`)
+}
+
+func testAccBucketBidirectionalReplicationConfig_firstRole(rName, firstRole string) string {
+	return acctest.ConfigCompose(testAccBucketBidirectionalReplicationConfig_versioned(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = aws_iam_role.test.assume_role_policy
+}
+
+resource "aws_s3_bucket_bidirectional_replication" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.first,
+    aws_s3_bucket_versioning.second,
+  ]
+
+  first_bucket  = aws_s3_bucket.first.bucket
+  first_role    = aws_iam_role.%[2]s.arn
+  second_bucket = aws_s3_bucket.second.bucket
+  second_role   = aws_iam_role.test.arn
+}
+`, rName, firstRole))
+}
@@ -5,8 +5,10 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"slices"
 	"strconv"
 	"strings"
@@ -253,6 +255,8 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
 	}
 }
 
@@ -261,6 +265,10 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
@@ -305,6 +313,10 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	const (
 		lifecycleConfigurationExtraRetryDelay    = 5 * time.Second
 		lifecycleConfigurationRulesSteadyTimeout = 2 * time.Minute
@@ -366,6 +378,10 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	rules := expandLifecycleRules(ctx, d.Get(names.AttrRule).([]interface{}))
 	input := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -403,6 +419,10 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
 	input := &s3.DeleteBucketLifecycleInput{
 		Bucket: aws.String(bucket),
 	}
@@ -454,6 +474,192 @@ func suppressMissingFilterConfigurationBlock(k, old, new string, d *schema.Resou
 	return false
 }
 
+// resourceBucketLifecycleConfigurationCustomizeDiff validates lifecycle rules at plan time
+// to catch configurations that would otherwise be rejected by the API during apply.
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Skip validation until all rule values are known.
+	if !d.GetRawConfig().GetAttr(names.AttrRule).IsWhollyKnown() {
+		return nil
+	}
+
+	return validateLifecycleRules(d.Get(names.AttrRule).([]interface{}))
+}
+
+// lifecycleTransitionStorageClassOrder is the order in which objects can move between storage classes.
+// Objects can only transition to a storage class later in the order.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/lifecycle-transition-general-considerations.html.
+var lifecycleTransitionStorageClassOrder = map[types.TransitionStorageClass]int{
+	types.TransitionStorageClassStandardIa:         1,
+	types.TransitionStorageClassIntelligentTiering: 2,
+	types.TransitionStorageClassOnezoneIa:          3,
+	types.TransitionStorageClassGlacierIr:          4,
+	types.TransitionStorageClassGlacier:            5,
+	types.TransitionStorageClassDeepArchive:        6,
+}
+
+const (
+	// Objects must be stored for at least 30 days before they can be transitioned to an infrequent access storage class
+	// and for at least 30 days in an infrequent access storage class before they can be transitioned to another storage class.
+	lifecycleTransitionInfrequentAccessMinimumDays = 30
+)
+
+type lifecycleTransition struct {
+	days         int
+	storageClass types.TransitionStorageClass
+}
+
+func validateLifecycleRules(tfList []interface{}) error {
+	var errs []error
+	ruleIDs := make(map[string]struct{})
+	var filters []map[string]interface{}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ruleID := tfMap[names.AttrID].(string)
+		if _, ok := ruleIDs[ruleID]; ok {
+			errs = append(errs, fmt.Errorf("rule %q: rule IDs must be unique", ruleID))
+		}
+		ruleIDs[ruleID] = struct{}{}
+
+		// Rules with exactly the same filter that transition objects to the same storage class are ambiguous.
+		filter := map[string]interface{}{
+			names.AttrFilter: tfMap[names.AttrFilter],
+			names.AttrPrefix: tfMap[names.AttrPrefix],
+		}
+		if tfMap[names.AttrStatus].(string) == lifecycleRuleStatusEnabled {
+			for j, v := range filters {
+				if v == nil || !reflect.DeepEqual(v, filter) {
+					continue
+				}
+
+				other := tfList[j].(map[string]interface{})
+				if lifecycleRulesHaveOverlappingTransitions(tfMap, other) {
+					errs = append(errs, fmt.Errorf("rule %q: filter overlaps with rule %q and both rules transition objects to the same storage class; combine the rules or use distinct filters", ruleID, other[names.AttrID].(string)))
+				}
+			}
+			filters = append(filters, filter)
+		} else {
+			filters = append(filters, nil)
+		}
+
+		var transitions, noncurrentTransitions []lifecycleTransition
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				// Date-based transitions can't be ordered against day-based ones.
+				if tfMap["date"].(string) != "" {
+					continue
+				}
+
+				transitions = append(transitions, lifecycleTransition{
+					days:         tfMap["days"].(int),
+					storageClass: types.TransitionStorageClass(tfMap[names.AttrStorageClass].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				noncurrentTransitions = append(noncurrentTransitions, lifecycleTransition{
+					days:         tfMap["noncurrent_days"].(int),
+					storageClass: types.TransitionStorageClass(tfMap[names.AttrStorageClass].(string)),
+				})
+			}
+		}
+
+		expirationDays := 0
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			expirationDays = v[0].(map[string]interface{})["days"].(int)
+		}
+
+		for _, err := range validateLifecycleTransitions(transitions, expirationDays, "transition", "days", "expiration") {
+			errs = append(errs, fmt.Errorf("rule %q (rule.%d): %w", ruleID, i, err))
+		}
+		for _, err := range validateLifecycleTransitions(noncurrentTransitions, 0, "noncurrent_version_transition", "noncurrent_days", "noncurrent_version_expiration") {
+			errs = append(errs, fmt.Errorf("rule %q (rule.%d): %w", ruleID, i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateLifecycleTransitions(transitions []lifecycleTransition, expirationDays int, transitionAttr, daysAttr, expirationAttr string) []error {
+	var errs []error
+
+	slices.SortStableFunc(transitions, func(a, b lifecycleTransition) int {
+		return a.days - b.days
+	})
+
+	storageClasses := make(map[types.TransitionStorageClass]struct{})
+	for i, v := range transitions {
+		if _, ok := storageClasses[v.storageClass]; ok {
+			errs = append(errs, fmt.Errorf("%s: storage class %s is specified more than once", transitionAttr, v.storageClass))
+		}
+		storageClasses[v.storageClass] = struct{}{}
+
+		switch v.storageClass {
+		case types.TransitionStorageClassStandardIa, types.TransitionStorageClassOnezoneIa:
+			if v.days < lifecycleTransitionInfrequentAccessMinimumDays {
+				errs = append(errs, fmt.Errorf("%s: %s (%d) for storage class %s must be at least %d", transitionAttr, daysAttr, v.days, v.storageClass, lifecycleTransitionInfrequentAccessMinimumDays))
+			}
+		}
+
+		if expirationDays > 0 && v.days >= expirationDays {
+			errs = append(errs, fmt.Errorf("%s: %s (%d) for storage class %s must be less than %s %s (%d)", transitionAttr, daysAttr, v.days, v.storageClass, expirationAttr, daysAttr, expirationDays))
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := transitions[i-1]
+		if lifecycleTransitionStorageClassOrder[v.storageClass] <= lifecycleTransitionStorageClassOrder[prev.storageClass] && v.storageClass != prev.storageClass {
+			errs = append(errs, fmt.Errorf("%s: objects can't transition from storage class %s (%s %d) to %s (%s %d)", transitionAttr, prev.storageClass, daysAttr, prev.days, v.storageClass, daysAttr, v.days))
+			continue
+		}
+
+		switch prev.storageClass {
+		case types.TransitionStorageClassStandardIa, types.TransitionStorageClassOnezoneIa:
+			if v.days < prev.days+lifecycleTransitionInfrequentAccessMinimumDays {
+				errs = append(errs, fmt.Errorf("%s: %s for storage class %s (%d) must be at least %d more than %s for storage class %s (%d)", transitionAttr, daysAttr, v.storageClass, v.days, lifecycleTransitionInfrequentAccessMinimumDays, daysAttr, prev.storageClass, prev.days))
+			}
+		}
+	}
+
+	return errs
+}
+
+// lifecycleRulesHaveOverlappingTransitions returns whether both rules define a transition to the same storage class.
+func lifecycleRulesHaveOverlappingTransitions(rule1, rule2 map[string]interface{}) bool {
+	for _, k := range []string{"transition", "noncurrent_version_transition"} {
+		v1, ok1 := rule1[k].(*schema.Set)
+		v2, ok2 := rule2[k].(*schema.Set)
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		storageClasses := make(map[string]struct{})
+		for _, v := range v1.List() {
+			storageClasses[v.(map[string]interface{})[names.AttrStorageClass].(string)] = struct{}{}
+		}
+		for _, v := range v2.List() {
+			if _, ok := storageClasses[v.(map[string]interface{})[names.AttrStorageClass].(string)]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) ([]types.LifecycleRule, error) {
 	input := &s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
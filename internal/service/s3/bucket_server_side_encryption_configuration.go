@@ -5,6 +5,7 @@ package s3
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -79,6 +80,8 @@ func resourceBucketServerSideEncryptionConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBucketServerSideEncryptionConfigurationCustomizeDiff,
 	}
 }
 
@@ -112,6 +115,8 @@ func resourceBucketServerSideEncryptionConfigurationCreate(ctx context.Context,
 
 	d.SetId(CreateResourceID(bucket, expectedBucketOwner))
 
+	diags = appendServerSideEncryptionRulesWarnings(diags, input.ServerSideEncryptionConfiguration.Rules)
+
 	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
 		return findServerSideEncryptionConfiguration(ctx, conn, bucket, expectedBucketOwner)
 	})
@@ -180,6 +185,8 @@ func resourceBucketServerSideEncryptionConfigurationUpdate(ctx context.Context,
 		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Server-side Encryption Configuration (%s): %s", d.Id(), err)
 	}
 
+	diags = appendServerSideEncryptionRulesWarnings(diags, input.ServerSideEncryptionConfiguration.Rules)
+
 	return append(diags, resourceBucketServerSideEncryptionConfigurationRead(ctx, d, meta)...)
 }
 
@@ -214,6 +221,64 @@ func resourceBucketServerSideEncryptionConfigurationDelete(ctx context.Context,
 	return diags
 }
 
+func resourceBucketServerSideEncryptionConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := validServerSideEncryptionRule(tfMap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validServerSideEncryptionRule validates combinations of arguments that S3 would otherwise only reject at apply time.
+func validServerSideEncryptionRule(tfMap map[string]interface{}) error {
+	v, ok := tfMap["apply_server_side_encryption_by_default"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	sse := v[0].(map[string]interface{})
+	sseAlgorithm := types.ServerSideEncryption(sse["sse_algorithm"].(string))
+
+	if kmsMasterKeyID := sse["kms_master_key_id"].(string); kmsMasterKeyID != "" && !isKMSServerSideEncryption(sseAlgorithm) {
+		return fmt.Errorf("kms_master_key_id can only be set when sse_algorithm is %q or %q", types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse)
+	}
+
+	if bucketKeyEnabled, ok := tfMap["bucket_key_enabled"].(bool); ok && bucketKeyEnabled && sseAlgorithm == types.ServerSideEncryptionAwsKmsDsse {
+		return fmt.Errorf("bucket_key_enabled can't be true when sse_algorithm is %q, S3 Bucket Keys aren't supported for DSSE-KMS", types.ServerSideEncryptionAwsKmsDsse)
+	}
+
+	return nil
+}
+
+func appendServerSideEncryptionRulesWarnings(diags diag.Diagnostics, rules []types.ServerSideEncryptionRule) diag.Diagnostics {
+	for _, rule := range rules {
+		if rule.ApplyServerSideEncryptionByDefault == nil || rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm != types.ServerSideEncryptionAwsKms {
+			continue
+		}
+
+		if !aws.ToBool(rule.BucketKeyEnabled) {
+			diags = sdkdiag.AppendWarningf(diags, "S3 Bucket Server-side Encryption Configuration uses %q without bucket_key_enabled. Enabling S3 Bucket Keys reduces the cost of requests to AWS KMS.", types.ServerSideEncryptionAwsKms)
+		}
+	}
+
+	return diags
+}
+
+func isKMSServerSideEncryption(sseAlgorithm types.ServerSideEncryption) bool {
+	return sseAlgorithm == types.ServerSideEncryptionAwsKms || sseAlgorithm == types.ServerSideEncryptionAwsKmsDsse
+}
+
 func findServerSideEncryptionConfiguration(ctx context.Context, conn *s3.Client, bucketName, expectedBucketOwner string) (*types.ServerSideEncryptionConfiguration, error) {
 	input := &s3.GetBucketEncryptionInput{
 		Bucket: aws.String(bucketName),
@@ -319,7 +384,8 @@ func flattenServerSideEncryptionByDefault(sse *types.ServerSideEncryptionByDefau
 		"sse_algorithm": sse.SSEAlgorithm,
 	}
 
-	if sse.KMSMasterKeyID != nil {
+	// Some partitions (e.g. ISO) continue to return the previous KMS key after switching to a non-KMS algorithm.
+	if sse.KMSMasterKeyID != nil && isKMSServerSideEncryption(sse.SSEAlgorithm) {
 		m["kms_master_key_id"] = aws.ToString(sse.KMSMasterKeyID)
 	}
 
@@ -367,6 +367,42 @@ func TestAccS3BucketServerSideEncryptionConfiguration_ApplySSEByDefault_BucketKe
 	})
 }
 
+func TestAccS3BucketServerSideEncryptionConfiguration_ApplySSEByDefault_KMSDSSEBucketKeyEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketServerSideEncryptionConfigurationConfig_applySSEByDefaultSSEAlgorithmKeyEnabled(rName, string(types.ServerSideEncryptionAwsKmsDsse), true),
+				ExpectError: regexache.MustCompile(`S3 Bucket Keys aren't supported for DSSE-KMS`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketServerSideEncryptionConfiguration_ApplySSEByDefault_AES256WithKMSMasterKeyID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketServerSideEncryptionConfigurationConfig_applySSEByDefaultAES256KMSMasterKeyID(rName),
+				ExpectError: regexache.MustCompile(`kms_master_key_id can only be set when sse_algorithm is`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketServerSideEncryptionConfiguration_migrate_noChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -612,6 +648,44 @@ resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
 `, rName, enabled)
 }
 
+func testAccBucketServerSideEncryptionConfigurationConfig_applySSEByDefaultSSEAlgorithmKeyEnabled(rName, sseAlgorithm string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = %[2]q
+    }
+    bucket_key_enabled = %[3]t
+  }
+}
+`, rName, sseAlgorithm, enabled)
+}
+
+func testAccBucketServerSideEncryptionConfigurationConfig_applySSEByDefaultAES256KMSMasterKeyID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = "alias/aws/s3"
+      sse_algorithm     = "AES256"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccBucketServerSideEncryptionConfigurationConfig_migrateNoChange(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -27,6 +27,7 @@ const (
 	errCodeNoSuchCORSConfiguration              = "NoSuchCORSConfiguration"
 	errCodeNoSuchLifecycleConfiguration         = "NoSuchLifecycleConfiguration"
 	errCodeNoSuchKey                            = "NoSuchKey"
+	errCodeNoSuchObjectLockConfiguration        = "NoSuchObjectLockConfiguration"
 	errCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
 	errCodeNoSuchTagSet                         = "NoSuchTagSet"
 	errCodeNoSuchWebsiteConfiguration           = "NoSuchWebsiteConfiguration"
@@ -5,9 +5,11 @@ package s3
 
 // Exports for use in tests only.
 var (
+	ResourceBucketABACTagPolicy                     = resourceBucketABACTagPolicy
 	ResourceBucketAccelerateConfiguration           = resourceBucketAccelerateConfiguration
 	ResourceBucketACL                               = resourceBucketACL
 	ResourceBucketAnalyticsConfiguration            = resourceBucketAnalyticsConfiguration
+	ResourceBucketBidirectionalReplication          = resourceBucketBidirectionalReplication
 	ResourceBucketCorsConfiguration                 = resourceBucketCorsConfiguration
 	ResourceBucketIntelligentTieringConfiguration   = resourceBucketIntelligentTieringConfiguration
 	ResourceBucketInventory                         = resourceBucketInventory
@@ -28,38 +30,40 @@ var (
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
-	BucketUpdateTags                      = bucketUpdateTags
-	BucketRegionalDomainName              = bucketRegionalDomainName
-	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
-	DeleteAllObjectVersions               = deleteAllObjectVersions
-	EmptyBucket                           = emptyBucket
-	FindAnalyticsConfiguration            = findAnalyticsConfiguration
-	FindBucket                            = findBucket
-	FindBucketACL                         = findBucketACL
-	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
-	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
-	FindBucketPolicy                      = findBucketPolicy
-	FindBucketRequestPayment              = findBucketRequestPayment
-	FindBucketVersioning                  = findBucketVersioning
-	FindBucketWebsite                     = findBucketWebsite
-	FindCORSRules                         = findCORSRules
-	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
-	FindInventoryConfiguration            = findInventoryConfiguration
-	FindLifecycleRules                    = findLifecycleRules
-	FindLoggingEnabled                    = findLoggingEnabled
-	FindMetricsConfiguration              = findMetricsConfiguration
-	FindObjectByBucketAndKey              = findObjectByBucketAndKey
-	FindObjectLockConfiguration           = findObjectLockConfiguration
-	FindOwnershipControls                 = findOwnershipControls
-	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
-	FindReplicationConfiguration          = findReplicationConfiguration
-	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
-	HostedZoneIDForRegion                 = hostedZoneIDForRegion
-	IsDirectoryBucket                     = isDirectoryBucket
-	ObjectListTags                        = objectListTags
-	ObjectUpdateTags                      = objectUpdateTags
-	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
-	ValidBucketName                       = validBucketName
+	BucketUpdateTags                       = bucketUpdateTags
+	BucketRegionalDomainName               = bucketRegionalDomainName
+	BucketWebsiteEndpointAndDomain         = bucketWebsiteEndpointAndDomain
+	DeleteAllObjectVersions                = deleteAllObjectVersions
+	EmptyBucket                            = emptyBucket
+	FindAnalyticsConfiguration             = findAnalyticsConfiguration
+	FindBucket                             = findBucket
+	FindBucketACL                          = findBucketACL
+	FindBucketBidirectionalReplicationRule = findBucketBidirectionalReplicationRule
+	FindBucketAccelerateConfiguration      = findBucketAccelerateConfiguration
+	FindBucketNotificationConfiguration    = findBucketNotificationConfiguration
+	FindBucketPolicy                       = findBucketPolicy
+	FindBucketRequestPayment               = findBucketRequestPayment
+	FindBucketVersioning                   = findBucketVersioning
+	FindBucketWebsite                      = findBucketWebsite
+	FindCORSRules                          = findCORSRules
+	FindIntelligentTieringConfiguration    = findIntelligentTieringConfiguration
+	FindInventoryConfiguration             = findInventoryConfiguration
+	FindLifecycleRules                     = findLifecycleRules
+	FindLoggingEnabled                     = findLoggingEnabled
+	FindMetricsConfiguration               = findMetricsConfiguration
+	FindObjectByBucketAndKey               = findObjectByBucketAndKey
+	FindObjectLegalHold                    = findObjectLegalHold
+	FindObjectLockConfiguration            = findObjectLockConfiguration
+	FindOwnershipControls                  = findOwnershipControls
+	FindPublicAccessBlockConfiguration     = findPublicAccessBlockConfiguration
+	FindReplicationConfiguration           = findReplicationConfiguration
+	FindServerSideEncryptionConfiguration  = findServerSideEncryptionConfiguration
+	HostedZoneIDForRegion                  = hostedZoneIDForRegion
+	IsDirectoryBucket                      = isDirectoryBucket
+	ObjectListTags                         = objectListTags
+	ObjectUpdateTags                       = objectUpdateTags
+	SDKv1CompatibleCleanKey                = sdkv1CompatibleCleanKey
+	ValidBucketName                        = validBucketName
 
 	BucketPropagationTimeout       = bucketPropagationTimeout
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
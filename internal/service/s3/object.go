@@ -6,7 +6,11 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
 	"net/http"
@@ -241,6 +245,16 @@ func resourceObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -565,7 +579,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.StorageClass = types.StorageClass(v.(string))
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := tftags.DefaultConfigFromContext(ctx, meta.(*conns.AWSClient).DefaultTagsConfig)
 	tags := tftags.New(ctx, getContextTags(ctx))
 	if ignoreProviderDefaultTags(ctx, d) {
 		tags = tags.RemoveDefaultConfig(defaultTagsConfig)
@@ -588,7 +602,14 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -642,9 +663,91 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 		d.SetNewComputed("etag")
 	}
 
+	// Detect changes to the local source file that aren't reflected in the configuration.
+	if d.Id() != "" {
+		if err := checkObjectSourceChecksum(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkObjectSourceChecksum(d *schema.ResourceDiff) error {
+	source, ok := d.GetOk(names.AttrSource)
+	if !ok {
+		return nil
+	}
+
+	var attr string
+	switch algorithm := types.ChecksumAlgorithm(d.Get("checksum_algorithm").(string)); algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		attr = "checksum_crc32"
+	case types.ChecksumAlgorithmCrc32c:
+		attr = "checksum_crc32c"
+	case types.ChecksumAlgorithmSha1:
+		attr = "checksum_sha1"
+	case types.ChecksumAlgorithmSha256:
+		attr = "checksum_sha256"
+	default:
+		return nil
+	}
+
+	// Checksums of objects uploaded in multiple parts are checksums of the part checksums ("<checksum>-<part count>")
+	// and can't be compared with the checksum of the whole file.
+	stored := d.Get(attr).(string)
+	if stored == "" || strings.Contains(stored, "-") {
+		return nil
+	}
+
+	path, err := homedir.Expand(source.(string))
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	checksum, err := fileChecksum(path, types.ChecksumAlgorithm(d.Get("checksum_algorithm").(string)))
+	if err != nil {
+		// The source may not exist yet, e.g. if it is generated during apply.
+		log.Printf("[WARN] Computing checksum of S3 object source (%s): %s", path, err)
+		return nil
+	}
+
+	if checksum != stored && !hasObjectContentChanges(d) {
+		return fmt.Errorf("the checksum of source (%s) has changed but no content change was detected; set source_hash (e.g. to filemd5(%q)) to trigger an update", path, source)
+	}
+
 	return nil
 }
 
+func fileChecksum(path string, algorithm types.ChecksumAlgorithm) (string, error) {
+	var h hash.Hash
+
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		h = crc32.NewIEEE()
+	case types.ChecksumAlgorithmCrc32c:
+		h = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case types.ChecksumAlgorithmSha1:
+		h = sha1.New()
+	case types.ChecksumAlgorithmSha256:
+		h = sha256.New()
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return itypes.Base64Encode(h.Sum(nil)), nil
+}
+
 func hasObjectContentChanges(d sdkv2.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
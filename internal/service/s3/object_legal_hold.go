@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_object_legal_hold", name="Object Legal Hold")
+func resourceObjectLegalHold() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectLegalHoldCreate,
+		ReadWithoutTimeout:   resourceObjectLegalHoldRead,
+		UpdateWithoutTimeout: resourceObjectLegalHoldUpdate,
+		DeleteWithoutTimeout: resourceObjectLegalHoldDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			names.AttrExpectedBucketOwner: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+				ExactlyOneOf: []string{names.AttrKey, names.AttrPrefix},
+			},
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrPrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+				ExactlyOneOf: []string{names.AttrKey, names.AttrPrefix},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectLockLegalHoldStatus](),
+			},
+			"version_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{names.AttrPrefix},
+			},
+		},
+	}
+}
+
+func resourceObjectLegalHoldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	status := types.ObjectLockLegalHoldStatus(d.Get(names.AttrStatus).(string))
+
+	var id string
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		key := v.(string)
+		id = fmt.Sprintf("%s/%s", bucket, key)
+
+		if err := putObjectLegalHold(ctx, conn, bucket, key, d.Get("version_id").(string), d.Get(names.AttrExpectedBucketOwner).(string), status); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Object Legal Hold (%s): %s", id, err)
+		}
+	} else {
+		prefix := d.Get(names.AttrPrefix).(string)
+		id = fmt.Sprintf("%s/%s", bucket, prefix)
+
+		if _, err := putObjectLegalHoldsByPrefix(ctx, conn, bucket, prefix, d.Get(names.AttrExpectedBucketOwner).(string), status); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Object Legal Hold (%s): %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceObjectLegalHoldRead(ctx, d, meta)...)
+}
+
+func resourceObjectLegalHoldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		legalHold, err := findObjectLegalHold(ctx, conn, bucket, v.(string), d.Get("version_id").(string), expectedBucketOwner)
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] S3 Object Legal Hold (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Object Legal Hold (%s): %s", d.Id(), err)
+		}
+
+		d.Set("object_count", 1)
+		d.Set(names.AttrStatus, legalHold.Status)
+
+		return diags
+	}
+
+	status := types.ObjectLockLegalHoldStatus(d.Get(names.AttrStatus).(string))
+	var nObjects int64
+
+	err := forEachObjectsPageWithPrefix(ctx, conn, bucket, d.Get(names.AttrPrefix).(string), expectedBucketOwner, func(page *s3.ListObjectsV2Output) error {
+		for _, v := range page.Contents {
+			key := aws.ToString(v.Key)
+
+			legalHold, err := findObjectLegalHold(ctx, conn, bucket, key, "", expectedBucketOwner)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return newObjectVersionError(key, "", err)
+			}
+
+			nObjects++
+
+			// Surface any object whose legal hold has drifted from the configured status.
+			if legalHold.Status != status {
+				status = legalHold.Status
+			}
+		}
+
+		return nil
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object Legal Hold (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object Legal Hold (%s): %s", d.Id(), err)
+	}
+
+	d.Set("object_count", nObjects)
+	d.Set(names.AttrStatus, status)
+
+	return diags
+}
+
+func resourceObjectLegalHoldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
+	status := types.ObjectLockLegalHoldStatus(d.Get(names.AttrStatus).(string))
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		if err := putObjectLegalHold(ctx, conn, bucket, v.(string), d.Get("version_id").(string), expectedBucketOwner, status); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Object Legal Hold (%s): %s", d.Id(), err)
+		}
+	} else {
+		if _, err := putObjectLegalHoldsByPrefix(ctx, conn, bucket, d.Get(names.AttrPrefix).(string), expectedBucketOwner, status); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Object Legal Hold (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceObjectLegalHoldRead(ctx, d, meta)...)
+}
+
+func resourceObjectLegalHoldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	expectedBucketOwner := d.Get(names.AttrExpectedBucketOwner).(string)
+
+	log.Printf("[DEBUG] Deleting S3 Object Legal Hold: %s", d.Id())
+	var err error
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		err = putObjectLegalHold(ctx, conn, bucket, v.(string), d.Get("version_id").(string), expectedBucketOwner, types.ObjectLockLegalHoldStatusOff)
+	} else {
+		_, err = putObjectLegalHoldsByPrefix(ctx, conn, bucket, d.Get(names.AttrPrefix).(string), expectedBucketOwner, types.ObjectLockLegalHoldStatusOff)
+	}
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Object Legal Hold (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func putObjectLegalHold(ctx context.Context, conn *s3.Client, bucket, key, versionID, expectedBucketOwner string, status types.ObjectLockLegalHoldStatus) error {
+	input := &s3.PutObjectLegalHoldInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		LegalHold: &types.ObjectLockLegalHold{
+			Status: status,
+		},
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	_, err := conn.PutObjectLegalHold(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotImplemented) {
+		err = errDirectoryBucket(err)
+	}
+
+	return err
+}
+
+// putObjectLegalHoldsByPrefix sets the legal hold status on the current version of every object under the specified prefix.
+// Every object is attempted, and all per-object errors are returned together so that a partial failure can be retried.
+// Returns the number of objects updated.
+func putObjectLegalHoldsByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix, expectedBucketOwner string, status types.ObjectLockLegalHoldStatus) (int64, error) {
+	var nObjects int64
+	var errs []error
+
+	err := forEachObjectsPageWithPrefix(ctx, conn, bucket, prefix, expectedBucketOwner, func(page *s3.ListObjectsV2Output) error {
+		for _, v := range page.Contents {
+			key := aws.ToString(v.Key)
+
+			if err := putObjectLegalHold(ctx, conn, bucket, key, "", expectedBucketOwner, status); err != nil {
+				if tfawserr.ErrCodeEquals(err, errCodeNoSuchKey) {
+					continue
+				}
+
+				errs = append(errs, newObjectVersionError(key, "", err))
+				continue
+			}
+
+			nObjects++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return nObjects, errors.Join(errs...)
+}
+
+// forEachObjectsPageWithPrefix calls the specified function for each page returned from the S3 ListObjectsV2 API for objects under the specified prefix.
+func forEachObjectsPageWithPrefix(ctx context.Context, conn *s3.Client, bucket, prefix, expectedBucketOwner string, fn func(page *s3.ListObjectsV2Output) error) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("listing S3 bucket (%s) objects: %w", bucket, err)
+		}
+
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findObjectLegalHold(ctx context.Context, conn *s3.Client, bucket, key, versionID, expectedBucketOwner string) (*types.ObjectLockLegalHold, error) {
+	input := &s3.GetObjectLegalHoldInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	output, err := conn.GetObjectLegalHold(ctx, input)
+
+	// An object that has never had a legal hold placed on it has no legal hold configuration.
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchObjectLockConfiguration) {
+		return &types.ObjectLockLegalHold{
+			Status: types.ObjectLockLegalHoldStatusOff,
+		}, nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey) || tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LegalHold == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LegalHold, nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ObjectLegalHold_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_legal_hold.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectLegalHoldDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectLegalHoldConfig_key(rName, string(types.ObjectLockLegalHoldStatusOn)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectLegalHoldStatus(ctx, resourceName, "aws_s3_object.test", types.ObjectLockLegalHoldStatusOn),
+					resource.TestCheckResourceAttr(resourceName, "object_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ObjectLockLegalHoldStatusOn)),
+				),
+			},
+			{
+				Config: testAccObjectLegalHoldConfig_key(rName, string(types.ObjectLockLegalHoldStatusOff)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectLegalHoldStatus(ctx, resourceName, "aws_s3_object.test", types.ObjectLockLegalHoldStatusOff),
+					resource.TestCheckResourceAttr(resourceName, "object_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ObjectLockLegalHoldStatusOff)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectLegalHold_prefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_legal_hold.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectLegalHoldDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectLegalHoldConfig_prefix(rName, string(types.ObjectLockLegalHoldStatusOn)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectLegalHoldStatus(ctx, resourceName, "aws_s3_object.test.0", types.ObjectLockLegalHoldStatusOn),
+					testAccCheckObjectLegalHoldStatus(ctx, resourceName, "aws_s3_object.test.2", types.ObjectLockLegalHoldStatusOn),
+					testAccCheckObjectLegalHoldStatus(ctx, resourceName, "aws_s3_object.other", types.ObjectLockLegalHoldStatusOff),
+					resource.TestCheckResourceAttr(resourceName, "object_count", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ObjectLockLegalHoldStatusOn)),
+				),
+			},
+			{
+				Config: testAccObjectLegalHoldConfig_prefix(rName, string(types.ObjectLockLegalHoldStatusOff)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectLegalHoldStatus(ctx, resourceName, "aws_s3_object.test.1", types.ObjectLockLegalHoldStatusOff),
+					resource.TestCheckResourceAttr(resourceName, "object_count", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ObjectLockLegalHoldStatusOff)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectLegalHoldDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_object_legal_hold" {
+				continue
+			}
+
+			key := rs.Primary.Attributes[names.AttrKey]
+			if key == "" {
+				continue
+			}
+
+			legalHold, err := tfs3.FindObjectLegalHold(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, rs.Primary.Attributes["version_id"], rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if legalHold.Status == types.ObjectLockLegalHoldStatusOn {
+				return fmt.Errorf("S3 Object Legal Hold %s still on", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectLegalHoldStatus(ctx context.Context, n, objectResourceName string, want types.ObjectLockLegalHoldStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		object, ok := s.RootModule().Resources[objectResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", objectResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		legalHold, err := tfs3.FindObjectLegalHold(ctx, conn, rs.Primary.Attributes[names.AttrBucket], object.Primary.Attributes[names.AttrKey], "", rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+		if err != nil {
+			return err
+		}
+
+		if got := legalHold.Status; got != want {
+			return fmt.Errorf("S3 Object (%s) legal hold status = %s, want %s", object.Primary.Attributes[names.AttrKey], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccObjectLegalHoldConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  object_lock_enabled = true
+  force_destroy       = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+`, rName)
+}
+
+func testAccObjectLegalHoldConfig_key(rName, status string) string {
+	return acctest.ConfigCompose(testAccObjectLegalHoldConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  # Must have bucket versioning enabled first
+  bucket        = aws_s3_bucket_versioning.test.bucket
+  key           = "test-key"
+  content       = "test"
+  force_destroy = true
+}
+
+resource "aws_s3_object_legal_hold" "test" {
+  bucket = aws_s3_object.test.bucket
+  key    = aws_s3_object.test.key
+  status = %[1]q
+}
+`, status))
+}
+
+func testAccObjectLegalHoldConfig_prefix(rName, status string) string {
+	return acctest.ConfigCompose(testAccObjectLegalHoldConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  count = 3
+
+  # Must have bucket versioning enabled first
+  bucket        = aws_s3_bucket_versioning.test.bucket
+  key           = "held/test-key-${count.index}"
+  content       = "test"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "other" {
+  bucket        = aws_s3_bucket_versioning.test.bucket
+  key           = "other/test-key"
+  content       = "test"
+  force_destroy = true
+}
+
+resource "aws_s3_object_legal_hold" "test" {
+  bucket = aws_s3_bucket_versioning.test.bucket
+  prefix = "held/"
+  status = %[1]q
+
+  depends_on = [aws_s3_object.test, aws_s3_object.other]
+}
+`, status))
+}
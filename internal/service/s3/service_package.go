@@ -33,6 +33,10 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 				o.Region = names.GlobalRegionID
 			}
 			o.UsePathStyle = config["s3_use_path_style"].(bool)
+			if v, ok := config["s3_disable_express_session_auth"].(bool); ok && v {
+				// Otherwise the value is resolved from the AWS_S3_DISABLE_EXPRESS_SESSION_AUTH environment variable or shared config file.
+				o.DisableS3ExpressSessionAuth = aws.Bool(true)
+			}
 
 			o.Retryer = conns.AddIsErrorRetryables(cfg.Retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
 				if tfawserr.ErrMessageContains(err, errCodeOperationAborted, "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
@@ -89,6 +89,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Bucket",
 			},
 		},
+		{
+			Factory:  resourceBucketABACTagPolicy,
+			TypeName: "aws_s3_bucket_abac_tag_policy",
+			Name:     "Bucket ABAC Tag Policy",
+		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
 			TypeName: "aws_s3_bucket_accelerate_configuration",
@@ -104,6 +109,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_analytics_configuration",
 			Name:     "Bucket Analytics Configuration",
 		},
+		{
+			Factory:  resourceBucketBidirectionalReplication,
+			TypeName: "aws_s3_bucket_bidirectional_replication",
+			Name:     "Bucket Bidirectional Replication",
+		},
 		{
 			Factory:  resourceBucketCorsConfiguration,
 			TypeName: "aws_s3_bucket_cors_configuration",
@@ -211,6 +221,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "ObjectCopy",
 			},
 		},
+		{
+			Factory:  resourceObjectLegalHold,
+			TypeName: "aws_s3_object_legal_hold",
+			Name:     "Object Legal Hold",
+		},
 	}
 }
 
@@ -7,10 +7,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -25,6 +32,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -76,6 +84,9 @@ func (r *accessGrantsLocationResource) Schema(ctx context.Context, request resou
 				CustomType: fwtypes.ARNType,
 				Required:   true,
 			},
+			"iam_role_trust_policy": schema.StringAttribute{
+				Computed: true,
+			},
 			"location_scope": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -127,6 +138,11 @@ func (r *accessGrantsLocationResource) Create(ctx context.Context, request resou
 	data.AccessGrantsLocationID = fwflex.StringToFramework(ctx, output.AccessGrantsLocationId)
 	data.setID()
 
+	response.Diagnostics.Append(r.setIAMRoleTrustPolicy(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -178,6 +194,11 @@ func (r *accessGrantsLocationResource) Read(ctx context.Context, request resourc
 
 	setTagsOut(ctx, Tags(tags))
 
+	response.Diagnostics.Append(r.setIAMRoleTrustPolicy(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -216,6 +237,13 @@ func (r *accessGrantsLocationResource) Update(ctx context.Context, request resou
 		}
 	}
 
+	if new.IAMRoleTrustPolicy.IsUnknown() {
+		response.Diagnostics.Append(r.setIAMRoleTrustPolicy(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
 		if err := updateTags(ctx, conn, new.AccessGrantsLocationARN.ValueString(), new.AccountID.ValueString(), oldTagsAll, newTagsAll); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("updating tags for S3 Access Grants Location (%s)", new.ID.ValueString()), err.Error())
@@ -260,9 +288,78 @@ func (r *accessGrantsLocationResource) Delete(ctx context.Context, request resou
 }
 
 func (r *accessGrantsLocationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var old, new accessGrantsLocationResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// The trust policy is only expected to change if the IAM role changes.
+		if new.IAMRoleARN.Equal(old.IAMRoleARN) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("iam_role_trust_policy"), old.IAMRoleTrustPolicy)...)
+		}
+	}
+
 	r.SetTagsAll(ctx, request, response)
 }
 
+// setIAMRoleTrustPolicy reads the trust policy of the location's IAM role so that out-of-band changes are detected.
+// A warning is emitted if the role can't be assumed by S3 Access Grants.
+func (r *accessGrantsLocationResource) setIAMRoleTrustPolicy(ctx context.Context, data *accessGrantsLocationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	roleARN := data.IAMRoleARN.ValueString()
+	policy, err := findIAMRoleTrustPolicy(ctx, r.Meta().IAMClient(ctx), roleARN)
+
+	if tfresource.NotFound(err) {
+		diags.AddWarning(fmt.Sprintf("IAM Role (%s) not found", roleARN), "S3 Access Grants Location IAM role trust policy can't be read.")
+		data.IAMRoleTrustPolicy = types.StringNull()
+
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading IAM Role (%s) trust policy", roleARN), err.Error())
+
+		return diags
+	}
+
+	if !strings.Contains(policy, accessGrantsServicePrincipal) {
+		diags.AddAttributeWarning(path.Root(names.AttrIAMRoleARN), "IAM role can't be assumed by S3 Access Grants", fmt.Sprintf("The trust policy of IAM Role (%s) does not allow the %s service principal.", roleARN, accessGrantsServicePrincipal))
+	}
+
+	data.IAMRoleTrustPolicy = types.StringValue(policy)
+
+	return diags
+}
+
+func findIAMRoleTrustPolicy(ctx context.Context, conn *iam.Client, roleARN string) (string, error) {
+	v, err := arn.Parse(roleARN)
+	if err != nil {
+		return "", err
+	}
+
+	// Strip any path from the role name.
+	name := v.Resource[strings.LastIndex(v.Resource, "/")+1:]
+	role, err := tfiam.FindRoleByName(ctx, conn, name)
+
+	if err != nil {
+		return "", err
+	}
+
+	policy, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(policy)
+}
+
 func findAccessGrantsLocationByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, locationID string) (*s3control.GetAccessGrantsLocationOutput, error) {
 	input := &s3control.GetAccessGrantsLocationInput{
 		AccessGrantsLocationId: aws.String(locationID),
@@ -294,6 +391,7 @@ type accessGrantsLocationResourceModel struct {
 	AccessGrantsLocationID  types.String `tfsdk:"access_grants_location_id"`
 	AccountID               types.String `tfsdk:"account_id"`
 	IAMRoleARN              fwtypes.ARN  `tfsdk:"iam_role_arn"`
+	IAMRoleTrustPolicy      types.String `tfsdk:"iam_role_trust_policy"`
 	ID                      types.String `tfsdk:"id"`
 	LocationScope           types.String `tfsdk:"location_scope"`
 	Tags                    types.Map    `tfsdk:"tags"`
@@ -302,6 +400,7 @@ type accessGrantsLocationResourceModel struct {
 
 const (
 	accessGrantsLocationResourceIDPartCount = 2
+	accessGrantsServicePrincipal            = "access-grants.s3.amazonaws.com"
 )
 
 func (data *accessGrantsLocationResourceModel) InitFromID() error {
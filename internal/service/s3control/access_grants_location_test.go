@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -36,6 +37,7 @@ func testAccAccessGrantsLocation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "access_grants_location_arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "access_grants_location_id"),
 					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAccountID),
+					resource.TestMatchResourceAttr(resourceName, "iam_role_trust_policy", regexache.MustCompile(`access-grants\.s3\.amazonaws\.com`)),
 					resource.TestCheckResourceAttr(resourceName, "location_scope", "s3://"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 				),
@@ -0,0 +1,736 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Batch Copy")
+func newBatchCopyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &batchCopyResource{}
+
+	r.SetDefaultCreateTimeout(24 * time.Hour)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type batchCopyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *batchCopyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3control_batch_copy"
+}
+
+func (r *batchCopyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPriority: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobStatus](),
+				Computed:   true,
+			},
+			names.AttrStorageClass: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.S3StorageClass](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_key_prefix": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[batchCopyFilterModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"created_after": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+						"created_before": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+						"prefixes": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"report": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[batchCopyReportModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"bucket_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrPrefix: schema.StringAttribute{
+							Optional: true,
+						},
+						"report_scope": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.JobReportScope](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.JobReportScopeAllTasks)),
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *batchCopyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data batchCopyResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(r.Meta().AccountID)
+	}
+
+	manifestGenerator, diags := data.expandManifestGenerator(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	report, diags := data.expandReport(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	operation := &awstypes.S3CopyObjectOperation{
+		TargetResource: fwflex.StringFromFramework(ctx, data.TargetBucketARN),
+	}
+	if v := data.StorageClass.ValueEnum(); v != "" {
+		operation.StorageClass = v
+	}
+	if v := data.TargetKeyPrefix.ValueString(); v != "" {
+		operation.TargetKeyPrefix = aws.String(v)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            fwflex.StringFromFramework(ctx, data.AccountID),
+		ClientRequestToken:   aws.String(id.UniqueId()),
+		ConfirmationRequired: aws.Bool(false),
+		Description:          fwflex.StringFromFramework(ctx, data.Description),
+		ManifestGenerator:    manifestGenerator,
+		Operation: &awstypes.JobOperation{
+			S3PutObjectCopy: operation,
+		},
+		Priority: fwflex.Int32FromFramework(ctx, data.Priority),
+		Report:   report,
+		RoleArn:  fwflex.StringFromFramework(ctx, data.RoleARN),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateJob(ctx, input)
+	}, errCodeInvalidRequest)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating S3 Batch Copy job", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.JobID = fwflex.StringToFramework(ctx, outputRaw.(*s3control.CreateJobOutput).JobId)
+	data.setID()
+
+	var job *awstypes.JobDescriptor
+	if data.WaitForCompletion.ValueBool() {
+		job, err = waitJobCompleted(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Batch Copy job (%s) complete", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	} else {
+		job, err = findJobByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Batch Copy job (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	data.Status = fwtypes.StringEnumValue(job.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *batchCopyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data batchCopyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	job, err := findJobByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		// S3 Batch Operations expires jobs 90 days after they finish.
+		// Keep the last known state of a finished job instead of removing the resource, which would copy the objects again.
+		if jobStatusIsTerminal(data.Status.ValueEnum()) {
+			tflog.Info(ctx, "S3 Batch Copy job expired, retaining state", map[string]any{
+				names.AttrID:     data.ID.ValueString(),
+				names.AttrStatus: data.Status.ValueString(),
+			})
+
+			return
+		}
+
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Batch Copy job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Description = fwflex.StringToFramework(ctx, job.Description)
+	response.Diagnostics.Append(data.flattenManifestGenerator(ctx, job.ManifestGenerator)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Priority = fwflex.Int32ValueToFramework(ctx, job.Priority)
+	response.Diagnostics.Append(data.flattenReport(ctx, job.Report)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.RoleARN = fwtypes.ARNValue(aws.ToString(job.RoleArn))
+	data.Status = fwtypes.StringEnumValue(job.Status)
+	if job.Operation != nil && job.Operation.S3PutObjectCopy != nil {
+		operation := job.Operation.S3PutObjectCopy
+		if operation.StorageClass != "" {
+			data.StorageClass = fwtypes.StringEnumValue(operation.StorageClass)
+		}
+		data.TargetBucketARN = fwtypes.ARNValue(aws.ToString(operation.TargetResource))
+		data.TargetKeyPrefix = fwflex.StringToFramework(ctx, operation.TargetKeyPrefix)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *batchCopyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new batchCopyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	// A finished job's priority is meaningless and the job may already have expired, so only record the new value.
+	if !new.Priority.Equal(old.Priority) && !jobStatusIsTerminal(old.Status.ValueEnum()) {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: fwflex.StringFromFramework(ctx, new.AccountID),
+			JobId:     fwflex.StringFromFramework(ctx, new.JobID),
+			Priority:  fwflex.Int32ValueFromFramework(ctx, new.Priority),
+		}
+
+		_, err := conn.UpdateJobPriority(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Batch Copy job (%s) priority", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	job, err := findJobByTwoPartKey(ctx, conn, new.AccountID.ValueString(), new.JobID.ValueString())
+
+	switch {
+	case tfresource.NotFound(err) && jobStatusIsTerminal(old.Status.ValueEnum()):
+		new.Status = old.Status
+	case err != nil:
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Batch Copy job (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	default:
+		new.Status = fwtypes.StringEnumValue(job.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *batchCopyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data batchCopyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ControlClient(ctx)
+
+	// Batch Operations jobs cannot be deleted. Cancel any job that is still in progress; finished jobs expire on their own.
+	job, err := findJobByTwoPartKey(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Batch Copy job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if jobStatusIsTerminal(job.Status) {
+		return
+	}
+
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          fwflex.StringFromFramework(ctx, data.AccountID),
+		JobId:              fwflex.StringFromFramework(ctx, data.JobID),
+		RequestedJobStatus: awstypes.RequestedJobStatusCancelled,
+	}
+
+	_, err = conn.UpdateJobStatus(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling S3 Batch Copy job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitJobCancelled(ctx, conn, data.AccountID.ValueString(), data.JobID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Batch Copy job (%s) cancel", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*awstypes.JobDescriptor, error) {
+	input := &s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Job, nil
+}
+
+func statusJob(ctx context.Context, conn *s3control.Client, accountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitJobCompleted(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*awstypes.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusNew, awstypes.JobStatusPreparing, awstypes.JobStatusReady, awstypes.JobStatusActive, awstypes.JobStatusCompleting),
+		Target:  enum.Slice(awstypes.JobStatusComplete),
+		Refresh: statusJob(ctx, conn, accountID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.JobDescriptor); ok {
+		tfresource.SetLastError(err, jobFailureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobCancelled(ctx context.Context, conn *s3control.Client, accountID, jobID string, timeout time.Duration) (*awstypes.JobDescriptor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusNew, awstypes.JobStatusPreparing, awstypes.JobStatusReady, awstypes.JobStatusActive, awstypes.JobStatusSuspended, awstypes.JobStatusPaused, awstypes.JobStatusPausing, awstypes.JobStatusCancelling, awstypes.JobStatusCompleting, awstypes.JobStatusFailing),
+		Target:  enum.Slice(awstypes.JobStatusCancelled, awstypes.JobStatusComplete, awstypes.JobStatusFailed),
+		Refresh: statusJob(ctx, conn, accountID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func jobStatusIsTerminal(status awstypes.JobStatus) bool {
+	switch status {
+	case awstypes.JobStatusCancelled, awstypes.JobStatusComplete, awstypes.JobStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+func jobFailureReasonsError(apiObjects []awstypes.JobFailure) error {
+	return errors.Join(tfslices.ApplyToAll(apiObjects, func(v awstypes.JobFailure) error {
+		return fmt.Errorf("%s: %s", aws.ToString(v.FailureCode), aws.ToString(v.FailureReason))
+	})...)
+}
+
+type batchCopyResourceModel struct {
+	AccountID         types.String                                          `tfsdk:"account_id"`
+	Description       types.String                                          `tfsdk:"description"`
+	Filter            fwtypes.ListNestedObjectValueOf[batchCopyFilterModel] `tfsdk:"filter"`
+	ID                types.String                                          `tfsdk:"id"`
+	JobID             types.String                                          `tfsdk:"job_id"`
+	Priority          types.Int64                                           `tfsdk:"priority"`
+	Report            fwtypes.ListNestedObjectValueOf[batchCopyReportModel] `tfsdk:"report"`
+	RoleARN           fwtypes.ARN                                           `tfsdk:"role_arn"`
+	SourceBucketARN   fwtypes.ARN                                           `tfsdk:"source_bucket_arn"`
+	Status            fwtypes.StringEnum[awstypes.JobStatus]                `tfsdk:"status"`
+	StorageClass      fwtypes.StringEnum[awstypes.S3StorageClass]           `tfsdk:"storage_class"`
+	TargetBucketARN   fwtypes.ARN                                           `tfsdk:"target_bucket_arn"`
+	TargetKeyPrefix   types.String                                          `tfsdk:"target_key_prefix"`
+	Timeouts          timeouts.Value                                        `tfsdk:"timeouts"`
+	WaitForCompletion types.Bool                                            `tfsdk:"wait_for_completion"`
+}
+
+type batchCopyFilterModel struct {
+	CreatedAfter  timetypes.RFC3339                `tfsdk:"created_after"`
+	CreatedBefore timetypes.RFC3339                `tfsdk:"created_before"`
+	Prefixes      fwtypes.SetValueOf[types.String] `tfsdk:"prefixes"`
+}
+
+type batchCopyReportModel struct {
+	BucketARN   fwtypes.ARN                                 `tfsdk:"bucket_arn"`
+	Prefix      types.String                                `tfsdk:"prefix"`
+	ReportScope fwtypes.StringEnum[awstypes.JobReportScope] `tfsdk:"report_scope"`
+}
+
+const (
+	batchCopyResourceIDPartCount = 2
+)
+
+func (data *batchCopyResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, batchCopyResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.AccountID = types.StringValue(parts[0])
+	data.JobID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *batchCopyResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.AccountID.ValueString(), data.JobID.ValueString()}, batchCopyResourceIDPartCount, false)))
+}
+
+func (data *batchCopyResourceModel) expandManifestGenerator(ctx context.Context) (awstypes.JobManifestGenerator, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := awstypes.S3JobManifestGenerator{
+		EnableManifestOutput: false,
+		SourceBucket:         fwflex.StringFromFramework(ctx, data.SourceBucketARN),
+	}
+
+	filter, d := data.Filter.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if filter != nil {
+		apiObject.Filter = &awstypes.JobManifestGeneratorFilter{}
+
+		if !filter.CreatedAfter.IsNull() {
+			v, d := filter.CreatedAfter.ValueRFC3339Time()
+			diags.Append(d...)
+			apiObject.Filter.CreatedAfter = aws.Time(v)
+		}
+
+		if !filter.CreatedBefore.IsNull() {
+			v, d := filter.CreatedBefore.ValueRFC3339Time()
+			diags.Append(d...)
+			apiObject.Filter.CreatedBefore = aws.Time(v)
+		}
+
+		if v := fwflex.ExpandFrameworkStringValueSet(ctx, filter.Prefixes); len(v) > 0 {
+			apiObject.Filter.KeyNameConstraint = &awstypes.KeyNameConstraint{
+				MatchAnyPrefix: v,
+			}
+		}
+	}
+
+	return &awstypes.JobManifestGeneratorMemberS3JobManifestGenerator{
+		Value: apiObject,
+	}, diags
+}
+
+func (data *batchCopyResourceModel) expandReport(ctx context.Context) (*awstypes.JobReport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	report, d := data.Report.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if report == nil {
+		return &awstypes.JobReport{
+			Enabled: false,
+		}, diags
+	}
+
+	apiObject := &awstypes.JobReport{
+		Bucket:      fwflex.StringFromFramework(ctx, report.BucketARN),
+		Enabled:     true,
+		Format:      awstypes.JobReportFormatReportCsv20180820,
+		Prefix:      fwflex.StringFromFramework(ctx, report.Prefix),
+		ReportScope: awstypes.JobReportScopeAllTasks,
+	}
+	if v := report.ReportScope.ValueEnum(); v != "" {
+		apiObject.ReportScope = v
+	}
+
+	return apiObject, diags
+}
+
+func (data *batchCopyResourceModel) flattenManifestGenerator(ctx context.Context, apiObject awstypes.JobManifestGenerator) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, ok := apiObject.(*awstypes.JobManifestGeneratorMemberS3JobManifestGenerator)
+	if !ok {
+		diags.AddError("reading S3 Batch Copy job", fmt.Sprintf("unexpected manifest generator (%T)", apiObject))
+
+		return diags
+	}
+
+	data.SourceBucketARN = fwtypes.ARNValue(aws.ToString(v.Value.SourceBucket))
+
+	filter := v.Value.Filter
+	if filter == nil || (filter.CreatedAfter == nil && filter.CreatedBefore == nil && (filter.KeyNameConstraint == nil || len(filter.KeyNameConstraint.MatchAnyPrefix) == 0)) {
+		data.Filter = fwtypes.NewListNestedObjectValueOfNull[batchCopyFilterModel](ctx)
+
+		return diags
+	}
+
+	tfObject := &batchCopyFilterModel{
+		CreatedAfter:  timetypes.NewRFC3339TimePointerValue(filter.CreatedAfter),
+		CreatedBefore: timetypes.NewRFC3339TimePointerValue(filter.CreatedBefore),
+		Prefixes:      fwtypes.NewSetValueOfNull[types.String](ctx),
+	}
+	if filter.KeyNameConstraint != nil {
+		tfObject.Prefixes = fwtypes.SetValueOf[types.String]{SetValue: fwflex.FlattenFrameworkStringValueSet(ctx, filter.KeyNameConstraint.MatchAnyPrefix)}
+	}
+
+	data.Filter, diags = fwtypes.NewListNestedObjectValueOfPtr(ctx, tfObject)
+
+	return diags
+}
+
+func (data *batchCopyResourceModel) flattenReport(ctx context.Context, apiObject *awstypes.JobReport) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil || !apiObject.Enabled {
+		data.Report = fwtypes.NewListNestedObjectValueOfNull[batchCopyReportModel](ctx)
+
+		return diags
+	}
+
+	tfObject := &batchCopyReportModel{
+		BucketARN:   fwtypes.ARNValue(aws.ToString(apiObject.Bucket)),
+		Prefix:      fwflex.StringToFramework(ctx, apiObject.Prefix),
+		ReportScope: fwtypes.StringEnumValue(apiObject.ReportScope),
+	}
+
+	data.Report, diags = fwtypes.NewListNestedObjectValueOfPtr(ctx, tfObject)
+
+	return diags
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlBatchCopy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JobDescriptor
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_batch_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchCopyConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchCopyExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefixes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filter.0.prefixes.*", "data/"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, "report.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "report.0.bucket_arn", "aws_s3_bucket.target", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "report.0.prefix", "reports"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.report_scope", string(types.JobReportScopeFailedTasksOnly)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_bucket_arn", "aws_s3_bucket.source", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusComplete)),
+					resource.TestCheckResourceAttr(resourceName, "target_key_prefix", "copied/"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts, "wait_for_completion"},
+			},
+			{
+				Config: testAccBatchCopyConfig_basic(rName, 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchCopyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "20"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.JobStatusComplete)),
+				),
+			},
+		},
+	})
+}
+
+// Batch Operations jobs cannot be deleted, so destroy only verifies that no job is left running.
+func testAccCheckBatchCopyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_batch_copy" {
+				continue
+			}
+
+			output, err := tfs3control.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAccountID], rs.Primary.Attributes["job_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			switch output.Status {
+			case types.JobStatusCancelled, types.JobStatusComplete, types.JobStatusFailed:
+				continue
+			}
+
+			return fmt.Errorf("S3 Batch Copy job %s still %s", rs.Primary.ID, output.Status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBatchCopyExists(ctx context.Context, n string, v *types.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		output, err := tfs3control.FindJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAccountID], rs.Primary.Attributes["job_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBatchCopyConfig_basic(rName string, priority int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "source" {
+  bucket        = "%[1]s-source"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket        = "%[1]s-target"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  count = 3
+
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "data/object-${count.index}"
+  content = "test"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "batchoperations.s3.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["s3:GetObject", "s3:GetObjectVersion", "s3:GetObjectTagging", "s3:ListBucket"]
+        Effect   = "Allow"
+        Resource = [aws_s3_bucket.source.arn, "${aws_s3_bucket.source.arn}/*"]
+      },
+      {
+        Action   = ["s3:PutObject", "s3:PutObjectTagging"]
+        Effect   = "Allow"
+        Resource = ["${aws_s3_bucket.target.arn}/*"]
+      },
+    ]
+  })
+}
+
+resource "aws_s3control_batch_copy" "test" {
+  priority          = %[2]d
+  role_arn          = aws_iam_role.test.arn
+  source_bucket_arn = aws_s3_bucket.source.arn
+  target_bucket_arn = aws_s3_bucket.target.arn
+  target_key_prefix = "copied/"
+
+  filter {
+    prefixes = ["data/"]
+  }
+
+  report {
+    bucket_arn   = aws_s3_bucket.target.arn
+    prefix       = "reports"
+    report_scope = "FailedTasksOnly"
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, rName, priority)
+}
@@ -12,6 +12,7 @@ var (
 	ResourceAccessPoint                        = resourceAccessPoint
 	ResourceAccessPointPolicy                  = resourceAccessPointPolicy
 	ResourceAccountPublicAccessBlock           = resourceAccountPublicAccessBlock
+	ResourceBatchCopy                          = newBatchCopyResource
 	ResourceBucket                             = resourceBucket
 	ResourceBucketLifecycleConfiguration       = resourceBucketLifecycleConfiguration
 	ResourceBucketPolicy                       = resourceBucketPolicy
@@ -20,6 +21,7 @@ var (
 	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
 	ResourceObjectLambdaAccessPointPolicy      = resourceObjectLambdaAccessPointPolicy
 	ResourceStorageLensConfiguration           = resourceStorageLensConfiguration
+	ResourceStorageLensGroup                   = resourceStorageLensGroup
 
 	FindAccessGrantByTwoPartKey                            = findAccessGrantByTwoPartKey
 	FindAccessGrantsInstance                               = findAccessGrantsInstance
@@ -28,6 +30,7 @@ var (
 	FindAccessPointByTwoPartKey                            = findAccessPointByTwoPartKey
 	FindAccessPointPolicyAndStatusByTwoPartKey             = findAccessPointPolicyAndStatusByTwoPartKey
 	FindBucketByTwoPartKey                                 = findBucketByTwoPartKey
+	FindJobByTwoPartKey                                    = findJobByTwoPartKey
 	FindBucketLifecycleConfigurationByTwoPartKey           = findBucketLifecycleConfigurationByTwoPartKey
 	FindBucketPolicyByTwoPartKey                           = findBucketPolicyByTwoPartKey
 	FindMultiRegionAccessPointByTwoPartKey                 = findMultiRegionAccessPointByTwoPartKey
@@ -37,4 +40,6 @@ var (
 	FindObjectLambdaAccessPointPolicyAndStatusByTwoPartKey = findObjectLambdaAccessPointPolicyAndStatusByTwoPartKey
 	FindPublicAccessBlockByAccountID                       = findPublicAccessBlockByAccountID
 	FindStorageLensConfigurationByAccountIDAndConfigID     = findStorageLensConfigurationByAccountIDAndConfigID
+	FindStorageLensGroupByTwoPartKey                       = findStorageLensGroupByTwoPartKey
+	StorageLensGroupParseResourceID                        = storageLensGroupParseResourceID
 )
@@ -39,6 +39,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Name:    "Access Grants Location",
 			Tags:    &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory: newBatchCopyResource,
+			Name:    "Batch Copy",
+		},
 	}
 }
 
@@ -107,6 +111,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Storage Lens Configuration",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  resourceStorageLensGroup,
+			TypeName: "aws_s3control_storage_lens_group",
+			Name:     "Storage Lens Group",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
@@ -227,6 +227,40 @@ func resourceStorageLensConfiguration() *schema.Resource {
 											},
 										},
 									},
+									"storage_lens_group_level": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"selection_criteria": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"exclude": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: verify.ValidARN,
+																},
+															},
+															"include": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: verify.ValidARN,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -718,6 +752,47 @@ func expandAccountLevel(tfMap map[string]interface{}) *types.AccountLevel {
 		apiObject.DetailedStatusCodesMetrics = expandDetailedStatusCodesMetrics(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["storage_lens_group_level"].([]interface{}); ok && len(v) > 0 {
+		// An empty block includes all Storage Lens groups in the home Region.
+		apiObject.StorageLensGroupLevel = &types.StorageLensGroupLevel{}
+
+		if v[0] != nil {
+			apiObject.StorageLensGroupLevel = expandStorageLensGroupLevel(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandStorageLensGroupLevel(tfMap map[string]interface{}) *types.StorageLensGroupLevel {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StorageLensGroupLevel{}
+
+	if v, ok := tfMap["selection_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SelectionCriteria = expandStorageLensGroupLevelSelectionCriteria(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandStorageLensGroupLevelSelectionCriteria(tfMap map[string]interface{}) *types.StorageLensGroupLevelSelectionCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StorageLensGroupLevelSelectionCriteria{}
+
+	if v, ok := tfMap["exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Exclude = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["include"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Include = flex.ExpandStringValueSet(v)
+	}
+
 	return apiObject
 }
 
@@ -1068,6 +1143,37 @@ func flattenAccountLevel(apiObject *types.AccountLevel) map[string]interface{} {
 		tfMap["detailed_status_code_metrics"] = []interface{}{flattenDetailedStatusCodesMetrics(v)}
 	}
 
+	if v := apiObject.StorageLensGroupLevel; v != nil {
+		tfMap["storage_lens_group_level"] = []interface{}{flattenStorageLensGroupLevel(v)}
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensGroupLevel(apiObject *types.StorageLensGroupLevel) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SelectionCriteria; v != nil {
+		tfMap["selection_criteria"] = []interface{}{flattenStorageLensGroupLevelSelectionCriteria(v)}
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensGroupLevelSelectionCriteria(apiObject *types.StorageLensGroupLevelSelectionCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	tfMap["exclude"] = apiObject.Exclude
+	tfMap["include"] = apiObject.Include
+
 	return tfMap
 }
 
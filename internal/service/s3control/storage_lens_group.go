@@ -0,0 +1,536 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_storage_lens_group", name="Storage Lens Group")
+// @Tags
+func resourceStorageLensGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStorageLensGroupCreate,
+		ReadWithoutTimeout:   resourceStorageLensGroupRead,
+		UpdateWithoutTimeout: resourceStorageLensGroupUpdate,
+		DeleteWithoutTimeout: resourceStorageLensGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: storageLensGroupFilterSchema(),
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func storageLensGroupFilterSchema() map[string]*schema.Schema {
+	s := storageLensGroupFilterConditionsSchema()
+
+	for _, k := range []string{"and", "or"} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: storageLensGroupFilterConditionsSchema(),
+			},
+		}
+	}
+
+	return s
+}
+
+func storageLensGroupFilterConditionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"match_any_prefix": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+		"match_any_suffix": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+		"match_any_tag": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrKey: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					names.AttrValue: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(0, 256),
+					},
+				},
+			},
+		},
+		"match_object_age": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"days_greater_than": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"days_less_than": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+		"match_object_size": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bytes_greater_than": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"bytes_less_than": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageLensGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		accountID = v.(string)
+	}
+	name := d.Get(names.AttrName).(string)
+	id := storageLensGroupCreateResourceID(accountID, name)
+	input := &s3control.CreateStorageLensGroupInput{
+		AccountId: aws.String(accountID),
+		StorageLensGroup: &types.StorageLensGroup{
+			Name: aws.String(name),
+		},
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.StorageLensGroup.Filter = expandStorageLensGroupFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateStorageLensGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Storage Lens Group (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceStorageLensGroupRead(ctx, d, meta)...)
+}
+
+func resourceStorageLensGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, name, err := storageLensGroupParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findStorageLensGroupByTwoPartKey(ctx, conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Storage Lens Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Storage Lens Group (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.ToString(output.StorageLensGroupArn)
+	d.Set(names.AttrAccountID, accountID)
+	d.Set(names.AttrARN, arn)
+	if output.Filter != nil {
+		if err := d.Set(names.AttrFilter, []interface{}{flattenStorageLensGroupFilter(output.Filter)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting filter: %s", err)
+		}
+	} else {
+		d.Set(names.AttrFilter, nil)
+	}
+	d.Set(names.AttrName, output.Name)
+
+	tags, err := listTags(ctx, conn, arn, accountID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Storage Lens Group (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, Tags(tags))
+
+	return diags
+}
+
+func resourceStorageLensGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, name, err := storageLensGroupParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &s3control.UpdateStorageLensGroupInput{
+			AccountId: aws.String(accountID),
+			Name:      aws.String(name),
+			StorageLensGroup: &types.StorageLensGroup{
+				Name: aws.String(name),
+			},
+		}
+
+		if v, ok := d.GetOk(names.AttrFilter); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.StorageLensGroup.Filter = expandStorageLensGroupFilter(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateStorageLensGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Storage Lens Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		if err := updateTags(ctx, conn, d.Get(names.AttrARN).(string), accountID, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Storage Lens Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStorageLensGroupRead(ctx, d, meta)...)
+}
+
+func resourceStorageLensGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID, name, err := storageLensGroupParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Storage Lens Group: %s", d.Id())
+	_, err = conn.DeleteStorageLensGroup(ctx, &s3control.DeleteStorageLensGroupInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Storage Lens Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const storageLensGroupResourceIDSeparator = ":"
+
+func storageLensGroupCreateResourceID(accountID, name string) string {
+	parts := []string{accountID, name}
+	id := strings.Join(parts, storageLensGroupResourceIDSeparator)
+
+	return id
+}
+
+func storageLensGroupParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, storageLensGroupResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sname", id, storageLensGroupResourceIDSeparator)
+}
+
+func findStorageLensGroupByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, name string) (*types.StorageLensGroup, error) {
+	input := &s3control.GetStorageLensGroupInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetStorageLensGroup(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageLensGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageLensGroup, nil
+}
+
+func expandStorageLensGroupFilter(tfMap map[string]interface{}) *types.StorageLensGroupFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StorageLensGroupFilter{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.And = &types.StorageLensGroupAndOperator{
+			MatchAnyPrefix:  expandStorageLensGroupMatchAnyPrefix(tfMap),
+			MatchAnySuffix:  expandStorageLensGroupMatchAnySuffix(tfMap),
+			MatchAnyTag:     expandStorageLensGroupMatchAnyTag(tfMap),
+			MatchObjectAge:  expandStorageLensGroupMatchObjectAge(tfMap),
+			MatchObjectSize: expandStorageLensGroupMatchObjectSize(tfMap),
+		}
+	}
+
+	apiObject.MatchAnyPrefix = expandStorageLensGroupMatchAnyPrefix(tfMap)
+	apiObject.MatchAnySuffix = expandStorageLensGroupMatchAnySuffix(tfMap)
+	apiObject.MatchAnyTag = expandStorageLensGroupMatchAnyTag(tfMap)
+	apiObject.MatchObjectAge = expandStorageLensGroupMatchObjectAge(tfMap)
+	apiObject.MatchObjectSize = expandStorageLensGroupMatchObjectSize(tfMap)
+
+	if v, ok := tfMap["or"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Or = &types.StorageLensGroupOrOperator{
+			MatchAnyPrefix:  expandStorageLensGroupMatchAnyPrefix(tfMap),
+			MatchAnySuffix:  expandStorageLensGroupMatchAnySuffix(tfMap),
+			MatchAnyTag:     expandStorageLensGroupMatchAnyTag(tfMap),
+			MatchObjectAge:  expandStorageLensGroupMatchObjectAge(tfMap),
+			MatchObjectSize: expandStorageLensGroupMatchObjectSize(tfMap),
+		}
+	}
+
+	return apiObject
+}
+
+func expandStorageLensGroupMatchAnyPrefix(tfMap map[string]interface{}) []string {
+	if v, ok := tfMap["match_any_prefix"].(*schema.Set); ok && v.Len() > 0 {
+		return flex.ExpandStringValueSet(v)
+	}
+
+	return nil
+}
+
+func expandStorageLensGroupMatchAnySuffix(tfMap map[string]interface{}) []string {
+	if v, ok := tfMap["match_any_suffix"].(*schema.Set); ok && v.Len() > 0 {
+		return flex.ExpandStringValueSet(v)
+	}
+
+	return nil
+}
+
+func expandStorageLensGroupMatchAnyTag(tfMap map[string]interface{}) []types.S3Tag {
+	v, ok := tfMap["match_any_tag"].(*schema.Set)
+
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.S3Tag, 0, v.Len())
+
+	for _, tfMapRaw := range v.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.S3Tag{
+			Key:   aws.String(tfMap[names.AttrKey].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandStorageLensGroupMatchObjectAge(tfMap map[string]interface{}) *types.MatchObjectAge {
+	v, ok := tfMap["match_object_age"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap = v[0].(map[string]interface{})
+	apiObject := &types.MatchObjectAge{}
+
+	if v, ok := tfMap["days_greater_than"].(int); ok && v != 0 {
+		apiObject.DaysGreaterThan = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["days_less_than"].(int); ok && v != 0 {
+		apiObject.DaysLessThan = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandStorageLensGroupMatchObjectSize(tfMap map[string]interface{}) *types.MatchObjectSize {
+	v, ok := tfMap["match_object_size"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap = v[0].(map[string]interface{})
+	apiObject := &types.MatchObjectSize{}
+
+	if v, ok := tfMap["bytes_greater_than"].(int); ok && v != 0 {
+		apiObject.BytesGreaterThan = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["bytes_less_than"].(int); ok && v != 0 {
+		apiObject.BytesLessThan = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenStorageLensGroupFilter(apiObject *types.StorageLensGroupFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := flattenStorageLensGroupFilterConditions(apiObject.MatchAnyPrefix, apiObject.MatchAnySuffix, apiObject.MatchAnyTag, apiObject.MatchObjectAge, apiObject.MatchObjectSize)
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{flattenStorageLensGroupFilterConditions(v.MatchAnyPrefix, v.MatchAnySuffix, v.MatchAnyTag, v.MatchObjectAge, v.MatchObjectSize)}
+	}
+
+	if v := apiObject.Or; v != nil {
+		tfMap["or"] = []interface{}{flattenStorageLensGroupFilterConditions(v.MatchAnyPrefix, v.MatchAnySuffix, v.MatchAnyTag, v.MatchObjectAge, v.MatchObjectSize)}
+	}
+
+	return tfMap
+}
+
+func flattenStorageLensGroupFilterConditions(matchAnyPrefix, matchAnySuffix []string, matchAnyTag []types.S3Tag, matchObjectAge *types.MatchObjectAge, matchObjectSize *types.MatchObjectSize) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"match_any_prefix": matchAnyPrefix,
+		"match_any_suffix": matchAnySuffix,
+	}
+
+	if len(matchAnyTag) > 0 {
+		tfList := make([]interface{}, 0, len(matchAnyTag))
+
+		for _, apiObject := range matchAnyTag {
+			tfList = append(tfList, map[string]interface{}{
+				names.AttrKey:   aws.ToString(apiObject.Key),
+				names.AttrValue: aws.ToString(apiObject.Value),
+			})
+		}
+
+		tfMap["match_any_tag"] = tfList
+	}
+
+	if v := matchObjectAge; v != nil {
+		tfMap["match_object_age"] = []interface{}{map[string]interface{}{
+			"days_greater_than": aws.ToInt32(v.DaysGreaterThan),
+			"days_less_than":    aws.ToInt32(v.DaysLessThan),
+		}}
+	}
+
+	if v := matchObjectSize; v != nil {
+		tfMap["match_object_size"] = []interface{}{map[string]interface{}{
+			"bytes_greater_than": aws.ToInt64(v.BytesGreaterThan),
+			"bytes_less_than":    aws.ToInt64(v.BytesLessThan),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlStorageLensGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageLensGroupExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrAccountID),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "s3", fmt.Sprintf("storage-lens-group/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.match_any_prefix.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filter.0.match_any_prefix.*", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.or.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensGroupExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3control.ResourceStorageLensGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageLensGroupConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccStorageLensGroupConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensGroup_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_storage_lens_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStorageLensGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensGroupConfig_and(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageLensGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_any_prefix.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_any_suffix.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_any_tag.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.0.and.0.match_any_tag.*", map[string]string{
+						names.AttrKey:   "Environment",
+						names.AttrValue: "production",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_object_age.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_object_age.0.days_greater_than", "10"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_object_age.0.days_less_than", "60"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_object_size.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_object_size.0.bytes_greater_than", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.0.match_object_size.0.bytes_less_than", "1048576"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.match_any_prefix.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.or.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageLensGroupConfig_or(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStorageLensGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.and.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.or.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.or.0.match_any_prefix.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.or.0.match_object_age.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.or.0.match_object_age.0.days_greater_than", "365"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.or.0.match_object_age.0.days_less_than", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageLensGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3control_storage_lens_group" {
+				continue
+			}
+
+			accountID, name, err := tfs3control.StorageLensGroupParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfs3control.FindStorageLensGroupByTwoPartKey(ctx, conn, accountID, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Storage Lens Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStorageLensGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		accountID, name, err := tfs3control.StorageLensGroupParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
+
+		_, err = tfs3control.FindStorageLensGroupByTwoPartKey(ctx, conn, accountID, name)
+
+		return err
+	}
+}
+
+func testAccStorageLensGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_group" "test" {
+  name = %[1]q
+
+  filter {
+    match_any_prefix = ["logs/"]
+  }
+}
+`, rName)
+}
+
+func testAccStorageLensGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_group" "test" {
+  name = %[1]q
+
+  filter {
+    match_any_prefix = ["logs/"]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccStorageLensGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_group" "test" {
+  name = %[1]q
+
+  filter {
+    match_any_prefix = ["logs/"]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccStorageLensGroupConfig_and(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_group" "test" {
+  name = %[1]q
+
+  filter {
+    and {
+      match_any_prefix = ["data/"]
+      match_any_suffix = [".csv", ".parquet"]
+
+      match_any_tag {
+        key   = "Environment"
+        value = "production"
+      }
+
+      match_object_age {
+        days_greater_than = 10
+        days_less_than    = 60
+      }
+
+      match_object_size {
+        bytes_greater_than = 1024
+        bytes_less_than    = 1048576
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStorageLensGroupConfig_or(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_group" "test" {
+  name = %[1]q
+
+  filter {
+    or {
+      match_any_prefix = ["archive/", "backup/"]
+
+      match_object_age {
+        days_greater_than = 365
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -61,6 +61,14 @@ func RegisterSweepers() {
 		Name: "aws_s3control_storage_lens_configuration",
 		F:    sweepStorageLensConfigurations,
 	})
+
+	resource.AddTestSweepers("aws_s3control_storage_lens_group", &resource.Sweeper{
+		Name: "aws_s3control_storage_lens_group",
+		F:    sweepStorageLensGroups,
+		Dependencies: []string{
+			"aws_s3control_storage_lens_configuration",
+		},
+	})
 }
 
 func sweepAccessGrants(region string) error {
@@ -385,3 +393,51 @@ func sweepStorageLensConfigurations(region string) error {
 
 	return nil
 }
+
+func sweepStorageLensGroups(region string) error {
+	ctx := sweep.Context(region)
+	if region == names.USGovEast1RegionID || region == names.USGovWest1RegionID {
+		log.Printf("[WARN] Skipping S3 Storage Lens Group sweep for region: %s", region)
+		return nil
+	}
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.S3ControlClient(ctx)
+	accountID := client.AccountID
+	input := &s3control.ListStorageLensGroupsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := s3control.NewListStorageLensGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping S3 Storage Lens Group sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Storage Lens Groups (%s): %w", region, err)
+		}
+
+		for _, v := range page.StorageLensGroupList {
+			r := resourceStorageLensGroup()
+			d := r.Data(nil)
+			d.SetId(storageLensGroupCreateResourceID(accountID, aws.ToString(v.Name)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Storage Lens Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -246,6 +247,28 @@ func testAccDataLake_lifeCycleUpdate(t *testing.T) {
 	})
 }
 
+func testAccDataLake_lifeCycleInvalidStorageClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataLakeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataLakeConfig_lifeCycleStorageClass(rName, "REDUCED_REDUNDANCY"),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
 func testAccDataLake_replication(t *testing.T) {
 	ctx := acctest.Context(t)
 	var datalake types.DataLakeResource
@@ -581,6 +604,34 @@ resource "aws_securitylake_data_lake" "test" {
 `, rName, acctest.Region()))
 }
 
+func testAccDataLakeConfig_lifeCycleStorageClass(rName, storageClass string) string {
+	return acctest.ConfigCompose(testAccDataLakeConfigConfig_base, fmt.Sprintf(`
+resource "aws_securitylake_data_lake" "test" {
+  meta_store_manager_role_arn = aws_iam_role.meta_store_manager.arn
+
+  configuration {
+    region = %[2]q
+
+    lifecycle_configuration {
+      transition {
+        days          = 31
+        storage_class = %[3]q
+      }
+      expiration {
+        days = 300
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_iam_role.meta_store_manager]
+}
+`, rName, acctest.Region(), storageClass))
+}
+
 func testAccDataLakeConfig_replication(rName string) string {
 	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), fmt.Sprintf(`
 resource "aws_securitylake_data_lake" "region_2" {
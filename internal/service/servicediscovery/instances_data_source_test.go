@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicediscovery_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceDiscoveryInstancesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_service_discovery_instances.test"
+	resourceName := "aws_service_discovery_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceDiscoveryEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.attributes.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.attributes.AWS_INSTANCE_IPV4", "10.0.0.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.attributes.stage", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceDiscoveryInstancesDataSource_queryParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_service_discovery_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceDiscoveryEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_queryParameters(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccInstancesDataSourceConfig_queryParameters(rName, "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstancesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+}
+
+resource "aws_service_discovery_service" "test" {
+  name         = %[1]q
+  namespace_id = aws_service_discovery_http_namespace.test.id
+}
+
+resource "aws_service_discovery_instance" "test" {
+  service_id  = aws_service_discovery_service.test.id
+  instance_id = %[1]q
+
+  attributes = {
+    AWS_INSTANCE_IPV4 = "10.0.0.1"
+    stage             = "test"
+  }
+}
+`, rName)
+}
+
+func testAccInstancesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_base(rName), `
+data "aws_service_discovery_instances" "test" {
+  namespace_name = aws_service_discovery_http_namespace.test.name
+  service_name   = aws_service_discovery_service.test.name
+
+  depends_on = [aws_service_discovery_instance.test]
+}
+`)
+}
+
+func testAccInstancesDataSourceConfig_queryParameters(rName, stage string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_service_discovery_instances" "test" {
+  namespace_name = aws_service_discovery_http_namespace.test.name
+  service_name   = aws_service_discovery_service.test.name
+
+  query_parameters = {
+    stage = %[1]q
+  }
+
+  depends_on = [aws_service_discovery_instance.test]
+}
+`, stage))
+}
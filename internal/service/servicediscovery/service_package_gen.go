@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_service_discovery_http_namespace",
 			Name:     "HTTP Namespace",
 		},
+		{
+			Factory:  dataSourceInstances,
+			TypeName: "aws_service_discovery_instances",
+			Name:     "Instances",
+		},
 		{
 			Factory:  dataSourceService,
 			TypeName: "aws_service_discovery_service",
@@ -101,7 +101,13 @@ var (
 		"platform_principal":               platformApplicationAttributeNamePlatformPrincipal,
 		"success_feedback_role_arn":        platformApplicationAttributeNameSuccessFeedbackRoleARN,
 		"success_feedback_sample_rate":     platformApplicationAttributeNameSuccessFeedbackSampleRate,
-	}, platformApplicationSchema).WithSkipUpdate("apple_platform_bundle_id").WithSkipUpdate("apple_platform_team_id").WithSkipUpdate("platform_credential").WithSkipUpdate("platform_principal")
+	}, platformApplicationSchema).WithSkipUpdate("apple_platform_bundle_id").WithSkipUpdate("apple_platform_team_id").WithSkipUpdate("platform_credential").WithSkipUpdate("platform_principal").
+		// The platform credential and principal are never returned by the API.
+		WithMissingSetToNil("apple_platform_bundle_id").WithMissingSetToNil("apple_platform_team_id").
+		WithMissingSetToNil("event_delivery_failure_topic_arn").WithMissingSetToNil("event_endpoint_created_topic_arn").
+		WithMissingSetToNil("event_endpoint_deleted_topic_arn").WithMissingSetToNil("event_endpoint_updated_topic_arn").
+		WithMissingSetToNil("failure_feedback_role_arn").WithMissingSetToNil("success_feedback_role_arn").
+		WithMissingSetToNil("success_feedback_sample_rate")
 )
 
 // @SDKResource("aws_sns_platform_application")
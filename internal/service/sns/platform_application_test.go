@@ -295,6 +295,19 @@ func TestAccSNSPlatformApplication_GCM_allAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "success_feedback_sample_rate", "50"),
 				),
 			},
+			{
+				Config: testAccPlatformApplicationConfig_gcmBasic(rName, apiKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlatformApplicationExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "event_delivery_failure_topic_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "event_endpoint_created_topic_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "event_endpoint_deleted_topic_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "event_endpoint_updated_topic_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "failure_feedback_role_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "success_feedback_role_arn"),
+					resource.TestCheckNoResourceAttr(resourceName, "success_feedback_sample_rate"),
+				),
+			},
 		},
 	})
 }
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,6 +39,7 @@ import (
 
 const (
 	documentPermissionsBatchLimit = 20
+	documentVersionsMax           = 1000
 )
 
 // @SDKResource("aws_ssm_document", name="Document")
@@ -198,6 +201,11 @@ func resourceDocument() *schema.Resource {
 					validation.StringLenBetween(1, 200),
 				),
 			},
+			"version_retention_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, documentVersionsMax-1),
+			},
 			"version_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -506,6 +514,12 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("version_retention_count"); ok {
+		if err := pruneDocumentVersions(ctx, conn, d.Id(), v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "pruning SSM Document (%s) versions: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceDocumentRead(ctx, d, meta)...)
 }
 
@@ -555,6 +569,86 @@ func resourceDocumentDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// pruneDocumentVersions deletes the oldest versions of the specified document so that at most keep versions remain.
+// The default version is never deleted.
+func pruneDocumentVersions(ctx context.Context, conn *ssm.Client, name string, keep int) error {
+	versions, err := findDocumentVersionsByName(ctx, conn, name)
+
+	if err != nil {
+		return err
+	}
+
+	if len(versions) <= keep {
+		return nil
+	}
+
+	// Newest versions first.
+	slices.SortFunc(versions, func(a, b awstypes.DocumentVersionInfo) int {
+		x, _ := strconv.Atoi(aws.ToString(a.DocumentVersion))
+		y, _ := strconv.Atoi(aws.ToString(b.DocumentVersion))
+		return y - x
+	})
+
+	retained := 0
+	for _, v := range versions {
+		if v.IsDefaultVersion {
+			retained++
+		}
+	}
+
+	for _, v := range versions {
+		if v.IsDefaultVersion {
+			continue
+		}
+
+		if retained < keep {
+			retained++
+			continue
+		}
+
+		version := aws.ToString(v.DocumentVersion)
+
+		log.Printf("[INFO] Deleting SSM Document (%s) version: %s", name, version)
+		_, err := conn.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
+			DocumentVersion: aws.String(version),
+			Name:            aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting version %s: %w", version, err)
+		}
+	}
+
+	return nil
+}
+
+func findDocumentVersionsByName(ctx context.Context, conn *ssm.Client, name string) ([]awstypes.DocumentVersionInfo, error) {
+	input := &ssm.ListDocumentVersionsInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.DocumentVersionInfo
+
+	pages := ssm.NewListDocumentVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidDocument](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DocumentVersions...)
+	}
+
+	return output, nil
+}
+
 func findDocumentByName(ctx context.Context, conn *ssm.Client, name string) (*awstypes.DocumentDescription, error) {
 	input := &ssm.DescribeDocumentInput{
 		Name: aws.String(name),
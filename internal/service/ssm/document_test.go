@@ -159,6 +159,52 @@ func TestAccSSMDocument_versionName(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_versionRetentionCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_versionRetentionCount(rName, "v1", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					testAccCheckDocumentVersionCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "version_retention_count", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_retention_count"},
+			},
+			{
+				Config: testAccDocumentConfig_versionRetentionCount(rName, "v2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					testAccCheckDocumentVersionCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_versionRetentionCount(rName, "v3", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					testAccCheckDocumentVersionCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -591,6 +637,29 @@ func testAccCheckDocumentExists(ctx context.Context, n string) resource.TestChec
 	}
 }
 
+func testAccCheckDocumentVersionCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindDocumentVersionsByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("SSM Document %s has %d versions, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDocumentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
@@ -710,6 +779,37 @@ DOC
 `, rName, version)
 }
 
+func testAccDocumentConfig_versionRetentionCount(rName, version string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name                    = %[1]q
+  document_type           = "Command"
+  version_retention_count = %[3]d
+
+  content = <<DOC
+    {
+       "schemaVersion": "2.0",
+       "description": "Sample version 2.0 document %[2]s",
+       "parameters": {
+
+       },
+       "mainSteps": [
+          {
+             "action": "aws:runPowerShellScript",
+             "name": "runPowerShellScript",
+             "inputs": {
+                "runCommand": [
+                   "Get-Process"
+                ]
+             }
+          }
+       ]
+    }
+DOC
+}
+`, rName, version, count)
+}
+
 func testAccDocumentConfig_20(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -23,6 +23,7 @@ var (
 	FindDefaultPatchBaselineByOperatingSystem          = findDefaultPatchBaselineByOperatingSystem
 	FindDefaultDefaultPatchBaselineIDByOperatingSystem = findDefaultDefaultPatchBaselineIDByOperatingSystem
 	FindDocumentByName                                 = findDocumentByName
+	FindDocumentVersionsByName                         = findDocumentVersionsByName
 	FindMaintenanceWindowByID                          = findMaintenanceWindowByID
 	FindMaintenanceWindowTargetByTwoPartKey            = findMaintenanceWindowTargetByTwoPartKey
 	FindMaintenanceWindowTaskByTwoPartKey              = findMaintenanceWindowTaskByTwoPartKey
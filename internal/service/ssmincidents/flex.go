@@ -403,8 +403,8 @@ func flattenPagerDutyIntegration(integrations []types.Integration) []interface{}
 				pagerDutyData[names.AttrName] = v
 			}
 
-			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration.ServiceId; v != nil {
-				pagerDutyData["service_id"] = v
+			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration; v != nil && v.ServiceId != nil {
+				pagerDutyData["service_id"] = v.ServiceId
 			}
 
 			if v := pagerDutyConfiguration.SecretId; v != nil {
@@ -279,6 +279,10 @@ func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		if d.HasChanges("integration") {
 			input.Integrations = expandIntegration(d.Get("integration").([]interface{}))
+			if input.Integrations == nil {
+				// An empty list removes all integrations.
+				input.Integrations = []types.Integration{}
+			}
 		}
 
 		_, err := client.UpdateResponsePlan(ctx, input)
@@ -777,71 +777,58 @@ func testAccResponsePlan_action(t *testing.T) {
 	})
 }
 
-//
-//	Comment out integration test as the configured PagerDuty secretId is invalid and the test will fail,
-//	as we do not want to expose credentials to public repository.
-//
-//	Tested locally and PagerDuty integration work with response plan.
-//
-//func testResponsePlan_integration(t *testing.T) {
-//	if testing.Short() {
-//		t.Skip("skipping long-running test in short mode")
-//	}
-//
-//  ctx := acctest.Context(t)
-//	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-//
-//	resourceName := "aws_ssmincidents_response_plan.test"
-//	pagerdutyName := "pagerduty-test-terraform"
-//	pagerdutyServiceId := "example"
-//	pagerdutySecretId := "example"
-//
-//	resource.Test(t, resource.TestCase{
-//		PreCheck: func() {
-//			acctest.PreCheck(ctx, t)
-//			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
-//		},
-//		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsServiceID),
-//		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-//		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
-//		Steps: []resource.TestStep{
-//			{
-//				Config: testAccResponsePlanConfig_pagerdutyIntegration(
-//					rName,
-//					pagerdutyName,
-//					pagerdutyServiceId,
-//					pagerdutySecretId,
-//				),
-//				Check: resource.ComposeTestCheckFunc(
-//					testAccCheckResponsePlanExists(ctx,resourceName),
-//					resource.TestCheckResourceAttr(resourceName, "integration.#", "1"),
-//					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.#", "1"),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.name",
-//						pagerdutyName,
-//					),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.service_id",
-//						pagerdutyServiceId,
-//					),
-//					resource.TestCheckResourceAttr(
-//						resourceName,
-//						"integration.0.pagerduty.0.secret_id",
-//						pagerdutySecretId,
-//					),
-//				),
-//			},
-//			{
-//				ResourceName:            resourceName,
-//				ImportState:             true,
-//				ImportStateVerify:       true,
-//				ImportStateVerifyIgnore: []string{"replication_set_arn"},
-//			},
-//		},
-//	})
-//}
+func testAccResponsePlan_integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	// PagerDuty credentials must not be committed to the repository,
+	// so the integration test only runs when they are provided.
+	pagerdutyServiceID := acctest.SkipIfEnvVarNotSet(t, "SSMINCIDENTS_PAGERDUTY_SERVICE_ID")
+	pagerdutySecretID := acctest.SkipIfEnvVarNotSet(t, "SSMINCIDENTS_PAGERDUTY_SECRET_ID")
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rTitle := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+	pagerdutyName := "pagerduty-test-terraform"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_pagerdutyIntegration(rName, pagerdutyName, pagerdutyServiceID, pagerdutySecretID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.name", pagerdutyName),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.service_id", pagerdutyServiceID),
+					resource.TestCheckResourceAttr(resourceName, "integration.0.pagerduty.0.secret_id", pagerdutySecretID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replication_set_arn"},
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName, rTitle, "3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
 
 func testAccCheckResponsePlanDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1318,31 +1305,27 @@ DOC
 `, name+"-test-documen-one", name+"-test-documen-two")
 }
 
-//func testAccResponsePlanConfig_pagerdutyIntegration(
-//	name,
-//	pagerdutyName,
-//	pagerdutyServiceId,
-//	pagerdutySecretId string) string {
-//	return acctest.ConfigCompose(
-//		testAccResponsePlanConfigBase(),
-//		fmt.Sprintf(`
-//resource "aws_ssmincidents_response_plan" "test" {
-//  name = %[1]q
-//
-//  incident_template {
-//    title  = %[1]q
-//    impact = "1"
-//  }
-//
-//  integration {
-//    pagerduty {
-//      name       = %[2]q
-//      service_id = %[3]q
-//      secret_id  = %[4]q
-//    }
-//  }
-//
-//  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
-//}
-//`, name, pagerdutyName, pagerdutyServiceId, pagerdutySecretId))
-//}
+func testAccResponsePlanConfig_pagerdutyIntegration(name, pagerdutyName, pagerdutyServiceID, pagerdutySecretID string) string {
+	return acctest.ConfigCompose(
+		testAccResponsePlanConfig_base(),
+		fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = "1"
+  }
+
+  integration {
+    pagerduty {
+      name       = %[2]q
+      service_id = %[3]q
+      secret_id  = %[4]q
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
+}
+`, name, pagerdutyName, pagerdutyServiceID, pagerdutySecretID))
+}
@@ -36,6 +36,7 @@ func TestAccSSMIncidents_serial(t *testing.T) {
 			"chatChannel":            testAccResponsePlan_chatChannel,
 			"engagement":             testAccResponsePlan_engagement,
 			"action":                 testAccResponsePlan_action,
+			"integration":            testAccResponsePlan_integration,
 		},
 		"Response Plan Data Source Tests": {
 			acctest.CtBasic: testAccResponsePlanDataSource_basic,
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagegateway
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_storagegateway_bandwidth_rate_limit_schedule", name="Bandwidth Rate Limit Schedule")
+func resourceBandwidthRateLimitSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBandwidthRateLimitSchedulePut,
+		ReadWithoutTimeout:   resourceBandwidthRateLimitScheduleRead,
+		UpdateWithoutTimeout: resourceBandwidthRateLimitSchedulePut,
+		DeleteWithoutTimeout: resourceBandwidthRateLimitScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bandwidth_rate_limit_interval": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"average_download_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(102400),
+						},
+						"average_upload_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(51200),
+						},
+						"days_of_week": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"end_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"end_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"start_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceBandwidthRateLimitSchedulePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).StorageGatewayConn(ctx)
+
+	gatewayARN := d.Get("gateway_arn").(string)
+	input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+		BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(d.Get("bandwidth_rate_limit_interval").([]interface{})),
+		GatewayARN:                  aws.String(gatewayARN),
+	}
+
+	_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Storage Gateway Bandwidth Rate Limit Schedule (%s): %s", gatewayARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(gatewayARN)
+	}
+
+	return append(diags, resourceBandwidthRateLimitScheduleRead(ctx, d, meta)...)
+}
+
+func resourceBandwidthRateLimitScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).StorageGatewayConn(ctx)
+
+	output, err := findBandwidthRateLimitScheduleByGatewayARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Storage Gateway Bandwidth Rate Limit Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Storage Gateway Bandwidth Rate Limit Schedule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("bandwidth_rate_limit_interval", flattenBandwidthRateLimitIntervals(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting bandwidth_rate_limit_interval: %s", err)
+	}
+	d.Set("gateway_arn", d.Id())
+
+	return diags
+}
+
+func resourceBandwidthRateLimitScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).StorageGatewayConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Storage Gateway Bandwidth Rate Limit Schedule: %s", d.Id())
+	_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+		BandwidthRateLimitIntervals: []*storagegateway.BandwidthRateLimitInterval{},
+		GatewayARN:                  aws.String(d.Id()),
+	})
+
+	if IsErrGatewayNotFound(err) || operationErrorCode(err) == operationErrCodeGatewayNotFound {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Storage Gateway Bandwidth Rate Limit Schedule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandBandwidthRateLimitIntervals(tfList []interface{}) []*storagegateway.BandwidthRateLimitInterval {
+	apiObjects := []*storagegateway.BandwidthRateLimitInterval{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &storagegateway.BandwidthRateLimitInterval{
+			DaysOfWeek:        flex.ExpandInt64Set(tfMap["days_of_week"].(*schema.Set)),
+			EndHourOfDay:      aws.Int64(int64(tfMap["end_hour_of_day"].(int))),
+			EndMinuteOfHour:   aws.Int64(int64(tfMap["end_minute_of_hour"].(int))),
+			StartHourOfDay:    aws.Int64(int64(tfMap["start_hour_of_day"].(int))),
+			StartMinuteOfHour: aws.Int64(int64(tfMap["start_minute_of_hour"].(int))),
+		}
+
+		if v, ok := tfMap["average_download_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageDownloadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["average_upload_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageUploadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBandwidthRateLimitIntervals(apiObjects []*storagegateway.BandwidthRateLimitInterval) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"average_download_rate_limit_in_bits_per_sec": aws.Int64Value(apiObject.AverageDownloadRateLimitInBitsPerSec),
+			"average_upload_rate_limit_in_bits_per_sec":   aws.Int64Value(apiObject.AverageUploadRateLimitInBitsPerSec),
+			"days_of_week":         flex.FlattenInt64Set(apiObject.DaysOfWeek),
+			"end_hour_of_day":      aws.Int64Value(apiObject.EndHourOfDay),
+			"end_minute_of_hour":   aws.Int64Value(apiObject.EndMinuteOfHour),
+			"start_hour_of_day":    aws.Int64Value(apiObject.StartHourOfDay),
+			"start_minute_of_hour": aws.Int64Value(apiObject.StartMinuteOfHour),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
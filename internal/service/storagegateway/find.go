@@ -196,3 +196,28 @@ func FindFileSystemAssociationByARN(ctx context.Context, conn *storagegateway.St
 
 	return output.FileSystemAssociationInfoList[0], nil
 }
+
+func findBandwidthRateLimitScheduleByGatewayARN(ctx context.Context, conn *storagegateway.StorageGateway, arn string) ([]*storagegateway.BandwidthRateLimitInterval, error) {
+	input := &storagegateway.DescribeBandwidthRateLimitScheduleInput{
+		GatewayARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeBandwidthRateLimitScheduleWithContext(ctx, input)
+
+	if IsErrGatewayNotFound(err) || operationErrorCode(err) == operationErrCodeGatewayNotFound {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.BandwidthRateLimitIntervals) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BandwidthRateLimitIntervals, nil
+}
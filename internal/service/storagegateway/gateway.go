@@ -167,6 +167,21 @@ func resourceGateway() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.IntBetween(0, 59),
 						},
+						"software_update_preferences": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"automatic_update_policy": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(storagegateway.AutomaticUpdatePolicy_Values(), false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -269,6 +284,22 @@ func resourceGateway() *schema.Resource {
 			customdiff.ForceNewIfChange("smb_active_directory_settings", func(_ context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// Storage Gateway does not support migrating an activated gateway to a different type.
+				// A replacement gateway can only be activated from a new appliance, so fail early
+				// rather than destroying the existing gateway and then failing activation.
+				if diff.Id() == "" || !diff.HasChange("gateway_type") {
+					return nil
+				}
+
+				if diff.HasChanges("activation_key", "gateway_ip_address") {
+					return nil
+				}
+
+				o, n := diff.GetChange("gateway_type")
+
+				return fmt.Errorf("gateway_type cannot be changed from %q to %q for an activated gateway; deploy a new %s gateway appliance and set activation_key or gateway_ip_address accordingly", o, n, n)
+			},
 			verify.SetTagsDiff,
 		),
 	}
@@ -880,6 +911,24 @@ func expandUpdateMaintenanceStartTimeInput(tfMap map[string]interface{}) *storag
 		apiObject.MinuteOfHour = aws.Int64(int64(v))
 	}
 
+	if v, ok := tfMap["software_update_preferences"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SoftwareUpdatePreferences = expandSoftwareUpdatePreferences(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSoftwareUpdatePreferences(tfMap map[string]interface{}) *storagegateway.SoftwareUpdatePreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &storagegateway.SoftwareUpdatePreferences{}
+
+	if v, ok := tfMap["automatic_update_policy"].(string); ok && v != "" {
+		apiObject.AutomaticUpdatePolicy = aws.String(v)
+	}
+
 	return apiObject
 }
 
@@ -906,6 +955,24 @@ func flattenDescribeMaintenanceStartTimeOutput(apiObject *storagegateway.Describ
 		tfMap["minute_of_hour"] = aws.Int64Value(v)
 	}
 
+	if v := apiObject.SoftwareUpdatePreferences; v != nil {
+		tfMap["software_update_preferences"] = []interface{}{flattenSoftwareUpdatePreferences(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSoftwareUpdatePreferences(apiObject *storagegateway.SoftwareUpdatePreferences) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutomaticUpdatePolicy; v != nil {
+		tfMap["automatic_update_policy"] = aws.StringValue(v)
+	}
+
 	return tfMap
 }
 
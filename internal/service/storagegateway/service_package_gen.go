@@ -36,6 +36,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBandwidthRateLimitSchedule,
+			TypeName: "aws_storagegateway_bandwidth_rate_limit_schedule",
+			Name:     "Bandwidth Rate Limit Schedule",
+		},
 		{
 			Factory:  resourceCache,
 			TypeName: "aws_storagegateway_cache",
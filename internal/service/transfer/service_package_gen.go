@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceConnector,
 			Name:    "Connector",
 		},
+		{
+			Factory: newDataSourceWorkflowExecution,
+			Name:    "Workflow Execution",
+		},
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
@@ -44,6 +45,10 @@ func resourceSSHKey() *schema.Resource {
 					return strings.Trim(old, "\n") == strings.Trim(new, "\n")
 				},
 			},
+			"date_imported": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"server_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -109,6 +114,11 @@ func resourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	d.Set("body", sshKey.SshPublicKeyBody)
+	if sshKey.DateImported != nil {
+		d.Set("date_imported", aws.ToTime(sshKey.DateImported).Format(time.RFC3339))
+	} else {
+		d.Set("date_imported", nil)
+	}
 	d.Set("server_id", serverID)
 	d.Set("ssh_key_id", sshKey.SshPublicKeyId)
 	d.Set(names.AttrUserName, user.UserName)
@@ -40,6 +40,7 @@ func testAccSSHKey_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSSHKeyExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttr(resourceName, "body", publicKey),
+					acctest.CheckResourceAttrRFC3339(resourceName, "date_imported"),
 					resource.TestCheckResourceAttrPair(resourceName, "server_id", "aws_transfer_server.test", names.AttrID),
 					resource.TestCheckResourceAttrSet(resourceName, "ssh_key_id"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrUserName, "aws_transfer_user.test", names.AttrUserName),
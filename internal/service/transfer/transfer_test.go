@@ -71,13 +71,14 @@ func TestAccTransfer_serial(t *testing.T) {
 			"System":             testAccTag_system,
 		},
 		"User": {
-			acctest.CtBasic:         testAccUser_basic,
-			acctest.CtDisappears:    testAccUser_disappears,
-			"tags":                  testAccUser_tags,
-			"HomeDirectoryMappings": testAccUser_homeDirectoryMappings,
-			"ModifyWithOptions":     testAccUser_modifyWithOptions,
-			"Posix":                 testAccUser_posix,
-			"UserNameValidation":    testAccUser_UserName_Validation,
+			acctest.CtBasic:            testAccUser_basic,
+			acctest.CtDisappears:       testAccUser_disappears,
+			"tags":                     testAccUser_tags,
+			"HomeDirectoryMappings":    testAccUser_homeDirectoryMappings,
+			"HomeDirectoryMappingsEFS": testAccUser_homeDirectoryMappingsEFS,
+			"ModifyWithOptions":        testAccUser_modifyWithOptions,
+			"Posix":                    testAccUser_posix,
+			"UserNameValidation":       testAccUser_UserName_Validation,
 		},
 	}
 
@@ -10,10 +10,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -134,7 +136,10 @@ func resourceUser() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffUserHomeDirectoryMappings,
+		),
 	}
 }
 
@@ -272,6 +277,11 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		if d.HasChange("posix_profile") {
 			input.PosixProfile = expandPOSIXProfile(d.Get("posix_profile").([]interface{}))
+
+			// An omitted list leaves the existing secondary GIDs in place.
+			if input.PosixProfile != nil && input.PosixProfile.SecondaryGids == nil {
+				input.PosixProfile.SecondaryGids = []int64{}
+			}
 		}
 
 		if d.HasChange(names.AttrRole) {
@@ -304,6 +314,88 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+func customizeDiffUserHomeDirectoryMappings(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if awstypes.HomeDirectoryType(d.Get("home_directory_type").(string)) != awstypes.HomeDirectoryTypeLogical {
+		return nil
+	}
+
+	// The server's domain can only be looked up once the server exists.
+	if !d.NewValueKnown("server_id") {
+		return nil
+	}
+
+	tfList := d.Get("home_directory_mappings").([]interface{})
+
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).TransferClient(ctx)
+	serverID := d.Get("server_id").(string)
+
+	server, err := findServerByID(ctx, conn, serverID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Transfer Server (%s): %w", serverID, err)
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		k := fmt.Sprintf("home_directory_mappings.%d.target", i)
+
+		if !d.NewValueKnown(k) {
+			continue
+		}
+
+		if err := validHomeDirectoryMappingTarget(server.Domain, tfMap[names.AttrTarget].(string)); err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+var (
+	homeDirectoryMappingTargetEFSFileSystemIDRegex = regexache.MustCompile(`^fs-[0-9a-f]{8,40}$`)
+	homeDirectoryMappingTargetS3BucketNameRegex    = regexache.MustCompile(`^[0-9a-z][0-9a-z.-]{1,61}[0-9a-z]$`)
+)
+
+// validHomeDirectoryMappingTarget checks that a LOGICAL home directory mapping target
+// begins with a storage location of the kind used by the server's domain.
+func validHomeDirectoryMappingTarget(domain awstypes.Domain, target string) error {
+	if !strings.HasPrefix(target, "/") {
+		return fmt.Errorf("%q must be an absolute path", target)
+	}
+
+	location, _, _ := strings.Cut(strings.TrimPrefix(target, "/"), "/")
+
+	// Session variables such as ${transfer:HomeBucket} are resolved by the service.
+	if strings.Contains(location, "${") {
+		return nil
+	}
+
+	switch domain {
+	case awstypes.DomainEfs:
+		if !homeDirectoryMappingTargetEFSFileSystemIDRegex.MatchString(location) {
+			return fmt.Errorf("%q must begin with an EFS file system ID (/fs-xxxxxxxx) for servers with domain %s", target, domain)
+		}
+	default:
+		if !homeDirectoryMappingTargetS3BucketNameRegex.MatchString(location) {
+			return fmt.Errorf("%q must begin with an S3 bucket name for servers with domain %s", target, awstypes.DomainS3)
+		}
+	}
+
+	return nil
+}
+
 const userResourceIDSeparator = "/"
 
 func userCreateResourceID(serverID, userName string) string {
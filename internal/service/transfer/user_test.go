@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -158,6 +159,21 @@ func testAccUser_posix(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "posix_profile.0.secondary_gids.#", acctest.Ct2),
 				),
 			},
+			{
+				Config: testAccUserConfig_posix(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.0.gid", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.0.uid", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "posix_profile.0.secondary_gids.#", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
@@ -298,6 +314,41 @@ func testAccUser_homeDirectoryMappings(t *testing.T) {
 	})
 }
 
+func testAccUser_homeDirectoryMappingsEFS(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedUser
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_posix(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &conf),
+				),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappingsEFS(rName, "/bucket3/tftestuser"),
+				ExpectError: regexache.MustCompile(`must begin with an EFS file system ID`),
+			},
+			{
+				Config: testAccUserConfig_homeDirectoryMappingsEFS(rName, "/fs-0123456789abcdef0/tftestuser"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_mappings.0.target", "/fs-0123456789abcdef0/tftestuser"),
+					resource.TestCheckResourceAttr(resourceName, "home_directory_type", "LOGICAL"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckUserExists(ctx context.Context, n string, v *awstypes.DescribedUser) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -742,3 +793,38 @@ resource "aws_transfer_user" "test" {
 }
 `, rName))
 }
+
+func testAccUserConfig_homeDirectoryMappingsEFS(rName, target string) string {
+	return acctest.ConfigCompose(testAccUserConfig_baseRole(rName), fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  domain = "EFS"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_transfer_user" "test" {
+  home_directory_type = "LOGICAL"
+  role                = aws_iam_role.test.arn
+  server_id           = aws_transfer_server.test.id
+  user_name           = "tftestuser"
+
+  home_directory_mappings {
+    entry  = "/"
+    target = %[2]q
+  }
+
+  posix_profile {
+    gid = 1000
+    uid = 1000
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, target))
+}
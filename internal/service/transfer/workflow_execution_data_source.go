@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Workflow Execution")
+func newDataSourceWorkflowExecution(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceWorkflowExecution{}, nil
+}
+
+const (
+	DSNameWorkflowExecution = "Workflow Execution Data Source"
+)
+
+type dataSourceWorkflowExecution struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceWorkflowExecution) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_transfer_workflow_execution"
+}
+
+func (d *dataSourceWorkflowExecution) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"execution_id": schema.StringAttribute{
+				Required: true,
+			},
+			"execution_role": schema.StringAttribute{
+				Computed: true,
+			},
+			"initial_file_location": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dsFileLocation](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[dsFileLocation](ctx),
+			},
+			"logging_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dsLoggingConfiguration](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[dsLoggingConfiguration](ctx),
+			},
+			"results": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dsExecutionResults](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[dsExecutionResults](ctx),
+			},
+			"service_metadata": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dsServiceMetadata](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[dsServiceMetadata](ctx),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ExecutionStatus](),
+				Computed:   true,
+			},
+			"workflow_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceWorkflowExecution) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().TransferClient(ctx)
+
+	var data dsWorkflowExecutionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findWorkflowExecutionByTwoPartKey(ctx, conn, data.WorkflowID.ValueString(), data.ExecutionID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionReading, DSNameWorkflowExecution, data.ExecutionID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findWorkflowExecutionByTwoPartKey(ctx context.Context, conn *transfer.Client, workflowID, executionID string) (*awstypes.DescribedExecution, error) {
+	input := &transfer.DescribeExecutionInput{
+		ExecutionId: aws.String(executionID),
+		WorkflowId:  aws.String(workflowID),
+	}
+
+	output, err := conn.DescribeExecution(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Execution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Execution, nil
+}
+
+type dsWorkflowExecutionData struct {
+	ExecutionID          types.String                                            `tfsdk:"execution_id"`
+	ExecutionRole        types.String                                            `tfsdk:"execution_role"`
+	InitialFileLocation  fwtypes.ListNestedObjectValueOf[dsFileLocation]         `tfsdk:"initial_file_location"`
+	LoggingConfiguration fwtypes.ListNestedObjectValueOf[dsLoggingConfiguration] `tfsdk:"logging_configuration"`
+	Results              fwtypes.ListNestedObjectValueOf[dsExecutionResults]     `tfsdk:"results"`
+	ServiceMetadata      fwtypes.ListNestedObjectValueOf[dsServiceMetadata]      `tfsdk:"service_metadata"`
+	Status               fwtypes.StringEnum[awstypes.ExecutionStatus]            `tfsdk:"status"`
+	WorkflowID           types.String                                            `tfsdk:"workflow_id"`
+}
+
+type dsFileLocation struct {
+	EfsFileLocation fwtypes.ListNestedObjectValueOf[dsEFSFileLocation] `tfsdk:"efs_file_location"`
+	S3FileLocation  fwtypes.ListNestedObjectValueOf[dsS3FileLocation]  `tfsdk:"s3_file_location"`
+}
+
+type dsEFSFileLocation struct {
+	FileSystemID types.String `tfsdk:"file_system_id"`
+	Path         types.String `tfsdk:"path"`
+}
+
+type dsS3FileLocation struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	Etag      types.String `tfsdk:"etag"`
+	Key       types.String `tfsdk:"key"`
+	VersionID types.String `tfsdk:"version_id"`
+}
+
+type dsLoggingConfiguration struct {
+	LogGroupName types.String `tfsdk:"log_group_name"`
+	LoggingRole  types.String `tfsdk:"logging_role"`
+}
+
+type dsExecutionResults struct {
+	OnExceptionSteps fwtypes.ListNestedObjectValueOf[dsExecutionStepResult] `tfsdk:"on_exception_steps"`
+	Steps            fwtypes.ListNestedObjectValueOf[dsExecutionStepResult] `tfsdk:"steps"`
+}
+
+type dsExecutionStepResult struct {
+	Error    fwtypes.ListNestedObjectValueOf[dsExecutionError] `tfsdk:"error"`
+	Outputs  types.String                                      `tfsdk:"outputs"`
+	StepType fwtypes.StringEnum[awstypes.WorkflowStepType]     `tfsdk:"step_type"`
+}
+
+type dsExecutionError struct {
+	Message types.String                                    `tfsdk:"message"`
+	Type    fwtypes.StringEnum[awstypes.ExecutionErrorType] `tfsdk:"type"`
+}
+
+type dsServiceMetadata struct {
+	UserDetails fwtypes.ListNestedObjectValueOf[dsUserDetails] `tfsdk:"user_details"`
+}
+
+type dsUserDetails struct {
+	ServerID  types.String `tfsdk:"server_id"`
+	SessionID types.String `tfsdk:"session_id"`
+	UserName  types.String `tfsdk:"user_name"`
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTransferWorkflowExecutionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Workflow executions are only started by file uploads to a server with
+	// an associated workflow, so an existing execution is required.
+	workflowID := acctest.SkipIfEnvVarNotSet(t, "TRANSFER_WORKFLOW_ID")
+	executionID := acctest.SkipIfEnvVarNotSet(t, "TRANSFER_WORKFLOW_EXECUTION_ID")
+	dataSourceName := "data.aws_transfer_workflow_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TransferEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowExecutionDataSourceConfig_basic(workflowID, executionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "execution_id", executionID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "execution_role"),
+					resource.TestCheckResourceAttr(dataSourceName, "initial_file_location.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "service_metadata.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "service_metadata.0.user_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(dataSourceName, "workflow_id", workflowID),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowExecutionDataSourceConfig_basic(workflowID, executionID string) string {
+	return fmt.Sprintf(`
+data "aws_transfer_workflow_execution" "test" {
+  workflow_id  = %[1]q
+  execution_id = %[2]q
+}
+`, workflowID, executionID)
+}
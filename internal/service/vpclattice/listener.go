@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -158,7 +159,10 @@ func resourceListener() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceListenerCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -166,6 +170,17 @@ const (
 	ResNameListener = "Listener"
 )
 
+func resourceListenerCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// TLS passthrough listeners only support forwarding to target groups.
+	if protocol := types.ListenerProtocol(d.Get(names.AttrProtocol).(string)); protocol == types.ListenerProtocolTlsPassthrough {
+		if v, ok := d.GetOk("default_action.0.fixed_response"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("default_action.0.fixed_response is not supported for %s listeners", protocol)
+		}
+	}
+
+	return nil
+}
+
 func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
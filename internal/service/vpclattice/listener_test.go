@@ -153,6 +153,29 @@ func TestAccVPCLatticeListener_fixedResponseHTTPS(t *testing.T) {
 	})
 }
 
+func TestAccVPCLatticeListener_fixedResponseTLSPassthrough(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccListenerConfig_fixedResponseTLSPassthrough(rName),
+				ExpectError: regexache.MustCompile(`fixed_response is not supported for TLS_PASSTHROUGH listeners`),
+			},
+		},
+	})
+}
+
 func TestAccVPCLatticeListener_forwardTLSPassthrough(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -588,6 +611,22 @@ resource "aws_vpclattice_listener" "test" {
 `, rName))
 }
 
+func testAccListenerConfig_fixedResponseTLSPassthrough(rName string) string {
+	return acctest.ConfigCompose(testAccListenerConfig_basic(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener" "test" {
+  name               = %[1]q
+  protocol           = "TLS_PASSTHROUGH"
+  port               = 8443
+  service_identifier = aws_vpclattice_service.test.id
+  default_action {
+    fixed_response {
+      status_code = 404
+    }
+  }
+}
+`, rName))
+}
+
 func testAccListenerConfig_forwardTLSPassthrough(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 0), fmt.Sprintf(`
 resource "aws_vpclattice_service" "test" {
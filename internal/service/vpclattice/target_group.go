@@ -113,10 +113,10 @@ func ResourceTargetGroup() *schema.Resource {
 										ValidateFunc: validation.IsPortNumber,
 									},
 									names.AttrProtocol: {
-										Type:             schema.TypeString,
-										Optional:         true,
-										Computed:         true,
-										ValidateDiagFunc: enum.Validate[types.TargetGroupProtocol](),
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(enum.Slice(types.TargetGroupProtocolHttp, types.TargetGroupProtocolHttps), false),
 									},
 									"protocol_version": {
 										Type:     schema.TypeString,
@@ -5,11 +5,13 @@ package xray
 
 // Exports for use in tests only.
 var (
-	FindEncryptionConfig   = findEncryptionConfig
-	FindGroupByARN         = findGroupByARN
-	FindSamplingRuleByName = findSamplingRuleByName
+	FindEncryptionConfig     = findEncryptionConfig
+	FindGroupByARN           = findGroupByARN
+	FindResourcePolicyByName = findResourcePolicyByName
+	FindSamplingRuleByName   = findSamplingRuleByName
 
 	ResourceEncryptionConfig = resourceEncryptionConfig
 	ResourceGroup            = resourceGroup
+	ResourceResourcePolicy   = newResourcePolicyResource
 	ResourceSamplingRule     = resourceSamplingRule
 )
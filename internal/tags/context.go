@@ -36,6 +36,17 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	return v, ok
 }
 
+// DefaultConfigFromContext returns the default tags configuration for the resource whose tagging information is kept in Context.
+// The returned configuration takes any per-resource type exclusion into account.
+// If Context holds no tagging information, defaultConfig is returned.
+func DefaultConfigFromContext(ctx context.Context, defaultConfig *DefaultConfig) *DefaultConfig {
+	if v, ok := FromContext(ctx); ok {
+		return v.DefaultConfig
+	}
+
+	return defaultConfig
+}
+
 type keyType int
 
 var tagKey keyType
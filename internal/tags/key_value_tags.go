@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -32,7 +33,8 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags                 KeyValueTags
+	ExcludeResourceTypes []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -56,6 +58,16 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResourceType returns the DefaultConfig to apply to resources of the given
+// type, or nil if the resource type is excluded from default tagging.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil || slices.Contains(dc.ExcludeResourceTypes, typeName) {
+		return nil
+	}
+
+	return dc
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -57,6 +57,71 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          KeyValueTags
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			typeName:      "aws_instance",
+			want:          nil,
+		},
+		{
+			name: "no exclusions",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			typeName: "aws_instance",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "resource type not excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+			},
+			typeName: "aws_instance",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "resource type excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group", "aws_instance"},
+			},
+			typeName: "aws_instance",
+			want:     nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName).GetTags()
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want.Map())
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
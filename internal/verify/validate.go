@@ -142,6 +142,93 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 	}
 }
 
+// ARNServiceResourceCheck returns an ARNCheckFunc that validates that a parsed ARN
+// is for the specified service and, if any are specified, one of the resource types (e.g. "role" in "role/MyRole").
+func ARNServiceResourceCheck(service string, resourceTypes ...string) ARNCheckFunc {
+	return func(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+		if !isARNServiceResource(arn, service, resourceTypes...) {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid %s ARN", k, v, arnServiceResourceDescription(service, resourceTypes...)))
+		}
+		return ws, errors
+	}
+}
+
+// isARNServiceResource returns whether or not the parsed ARN is for the specified service and,
+// if any are specified, one of the resource types. The resource type may be delimited by either '/' or ':'.
+func isARNServiceResource(arn arn.ARN, service string, resourceTypes ...string) bool {
+	if arn.Service != service {
+		return false
+	}
+
+	if len(resourceTypes) == 0 {
+		return true
+	}
+
+	for _, resourceType := range resourceTypes {
+		if strings.HasPrefix(arn.Resource, resourceType+"/") || strings.HasPrefix(arn.Resource, resourceType+":") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func arnServiceResourceDescription(service string, resourceTypes ...string) string {
+	if len(resourceTypes) == 0 {
+		return service
+	}
+
+	return service + " " + strings.Join(resourceTypes, " or ")
+}
+
+// ValidIAMRoleARN validates that a string value is an IAM role ARN.
+var ValidIAMRoleARN = ValidARNCheck(ARNServiceResourceCheck("iam", "role"))
+
+// ValidKMSKeyARN validates that a string value is a KMS key ARN.
+// KMS alias ARNs are invalid; use ValidKMSKeyOrAliasARN for arguments that also accept aliases.
+var ValidKMSKeyARN = ValidARNCheck(ARNServiceResourceCheck("kms", "key"))
+
+// ValidKMSKeyOrAliasARN validates that a string value is a KMS key or alias ARN.
+var ValidKMSKeyOrAliasARN = ValidARNCheck(ARNServiceResourceCheck("kms", "key", "alias"))
+
+// ValidateEC2ResourceID returns an error if the specified value is not an EC2-style
+// resource ID with the given prefix, e.g. "subnet-0123456789abcdef0".
+// Both the legacy 8 character and the current 17 character hexadecimal suffixes are valid.
+func ValidateEC2ResourceID(prefix, id string) error {
+	if !regexache.MustCompile(`^` + regexp.QuoteMeta(prefix) + `-([0-9a-f]{8}|[0-9a-f]{17})$`).MatchString(id) {
+		return fmt.Errorf("expected an ID of the form %s-xxxxxxxx or %s-xxxxxxxxxxxxxxxxx", prefix, prefix)
+	}
+
+	return nil
+}
+
+// ValidEC2ResourceID returns a SchemaValidateFunc which validates that a string value is
+// an EC2-style resource ID with the given prefix.
+func ValidEC2ResourceID(prefix string) schema.SchemaValidateFunc {
+	return func(v any, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return ws, errors
+		}
+
+		if err := ValidateEC2ResourceID(prefix, value); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is invalid: %w", k, value, err))
+		}
+
+		return ws, errors
+	}
+}
+
+// ValidSecurityGroupID validates that a string value is a VPC security group ID.
+var ValidSecurityGroupID = ValidEC2ResourceID("sg")
+
+// ValidSubnetID validates that a string value is a VPC subnet ID.
+var ValidSubnetID = ValidEC2ResourceID("subnet")
+
+// ValidVPCID validates that a string value is a VPC ID.
+var ValidVPCID = ValidEC2ResourceID("vpc")
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -225,6 +225,119 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidIAMRoleARN(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"arn:aws:iam::123456789012:role/MyRole",                                                         // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/MyServiceRole",                                     // lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:role/aws-service-role/elasticache.amazonaws.com/MyLinkedRole", // lintignore:AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := ValidIAMRoleARN(v, "role_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"MyRole",
+		"arn:aws:iam::123456789012:user/David",          // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:roles/MyRole",        // lintignore:AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/MyRole", // lintignore:AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidIAMRoleARN(v, "role_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+}
+
+func TestValidKMSKeyARN(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := ValidKMSKeyARN(v, "kms_key_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid KMS key ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:kms:us-west-2:123456789012:alias/my-key",                              // lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",           // lintignore:AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:keys/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidKMSKeyARN(v, "kms_key_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid KMS key ARN", v)
+		}
+	}
+}
+
+func TestValidKMSKeyOrAliasARN(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:alias/my-key",                             // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := ValidKMSKeyOrAliasARN(v, "kms_key_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid KMS key or alias ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"alias/my-key",
+		"arn:aws:kms:us-west-2:123456789012:aliases/my-key",                  // lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidKMSKeyOrAliasARN(v, "kms_key_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid KMS key or alias ARN", v)
+		}
+	}
+}
+
+func TestValidEC2ResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		validate schema.SchemaValidateFunc
+		value    string
+		valid    bool
+	}{
+		{ValidSubnetID, "subnet-12345678", true},
+		{ValidSubnetID, "subnet-0123456789abcdef0", true},
+		{ValidSubnetID, "subnet-0123456789ABCDEF0", false},
+		{ValidSubnetID, "subnet-1234567", false},
+		{ValidSubnetID, "subnet-0123456789abcdef", false},
+		{ValidSubnetID, "subnet_12345678", false},
+		{ValidSubnetID, "sg-12345678", false},
+		{ValidSubnetID, "", false},
+		{ValidSecurityGroupID, "sg-0123456789abcdef0", true},
+		{ValidSecurityGroupID, "default", false},
+		{ValidVPCID, "vpc-12345678", true},
+		{ValidVPCID, "vpce-12345678", false},
+	}
+
+	for _, testCase := range testCases {
+		_, errors := testCase.validate(testCase.value, "id")
+		if got, want := len(errors) == 0, testCase.valid; got != want {
+			t.Errorf("%q valid = %t, want %t: %q", testCase.value, got, want, errors)
+		}
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 